@@ -0,0 +1,19 @@
+data "distribution_edge_nodes" "all" {}
+
+resource "distribution_permission_target" "my_permission" {
+  name          = "my-permission"
+  resource_type = "destination"
+  distribution_destinations = [
+    for edge in data.distribution_edge_nodes.all.edge_nodes : {
+      site_name     = edge.site_name
+      city_name     = edge.city_name
+      country_codes = [edge.country_code]
+    } if !edge.license_expired
+  ]
+
+  principals = {
+    groups = {
+      "release-managers" = ["x", "d"]
+    }
+  }
+}
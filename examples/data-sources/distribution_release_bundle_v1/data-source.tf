@@ -0,0 +1,8 @@
+data "distribution_release_bundle_v1" "my-release-bundle-v1" {
+  name    = "my-release-bundle-v1"
+  version = "1.0.0"
+}
+
+output "my-release-bundle-v1-state" {
+  value = data.distribution_release_bundle_v1.my-release-bundle-v1.state
+}
@@ -0,0 +1,8 @@
+data "distribution_release_bundle_v1_distribution_status" "my-release-bundle-v1" {
+  name    = "my-release-bundle-v1"
+  version = "1.0.0"
+}
+
+output "distributed-to-all-edges" {
+  value = data.distribution_release_bundle_v1_distribution_status.my-release-bundle-v1.all_completed
+}
@@ -0,0 +1,10 @@
+data "distribution_release_bundle_v1_versions" "my-release-bundle-v1-signed" {
+  name               = "my-release-bundle-v1"
+  state              = "SIGNED"
+  version_constraint = ">= 1.0.0, < 2.0.0"
+  order_by           = "version"
+}
+
+output "latest-signed-version" {
+  value = data.distribution_release_bundle_v1_versions.my-release-bundle-v1-signed.versions[0].version
+}
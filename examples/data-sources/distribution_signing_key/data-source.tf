@@ -0,0 +1,12 @@
+data "distribution_signing_key" "default-gpg-key" {
+  protocol = "gpg"
+}
+
+data "distribution_signing_key" "my-gpg-key" {
+  protocol = distribution_signing_key.my-gpg-key.protocol
+  alias    = distribution_signing_key.my-gpg-key.alias
+}
+
+output "my-gpg-key-fingerprint" {
+  value = data.distribution_signing_key.my-gpg-key.fingerprint
+}
@@ -0,0 +1,7 @@
+data "distribution_signing_keys" "gpg-keys" {
+  protocol = "gpg"
+}
+
+output "gpg-public-keys" {
+  value = { for key in data.distribution_signing_keys.gpg-keys.keys : key.alias => key.public_key }
+}
@@ -0,0 +1,14 @@
+ephemeral "distribution_signing_key_secrets" "my-gpg-signing-key" {
+  private_key_path = "${path.module}/private.asc"
+  passphrase_env = "GPG_PASSPHRASE"
+}
+
+resource "distribution_signing_key" "my-gpg-signing-key" {
+  protocol = "gpg"
+  alias = "my-gpg-signing-key"
+
+  public_key = file("${path.module}/public.asc")
+  private_key_wo = ephemeral.distribution_signing_key_secrets.my-gpg-signing-key.private_key
+  passphrase_wo = ephemeral.distribution_signing_key_secrets.my-gpg-signing-key.passphrase
+  key_wo_version = 1
+}
@@ -0,0 +1 @@
+import distribution_default_signing_key.gpg gpg
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "distribution_default_signing_key" "gpg" {
+  protocol = "gpg"
+  alias    = distribution_signing_key.my-gpg-signing-key.alias
+}
@@ -17,4 +17,26 @@ resource "distribution_permission_target" "my_permission" {
       "grp1" = ["x","d"]
     }
   }
+}
+
+resource "distribution_permission_target" "my_release_bundle_permission" {
+  name          = "my-release-bundle-permission"
+  resource_type = "release_bundle"
+  release_bundle = {
+    include_patterns = ["app-*"]
+    exclude_patterns = ["app-internal-*"]
+  }
+  distribution_destinations = [
+    {
+      site_name     = "*"
+      city_name     = "*"
+      country_codes = ["DE", "FR"]
+    }
+  ]
+
+  principals = {
+    groups = {
+      "team-x" = ["r","x"]
+    }
+  }
 }
\ No newline at end of file
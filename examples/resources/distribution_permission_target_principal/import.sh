@@ -0,0 +1 @@
+import distribution_permission_target_principal.team_x my-permission:group:team-x
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "distribution_permission_target_principal" "team_x" {
+  permission_target_name = "my-permission"
+  type                   = "group"
+  name                   = "team-x"
+  actions                = ["read", "distribute"]
+}
\ No newline at end of file
@@ -2,6 +2,7 @@ resource "distribution_release_bundle_v1" "my-release-bundle-v1" {
   name = "my-release-bundle-v1"
   version = "1.0.0"
   sign_immediately = true
+  wait_for_state = "SIGNED"
   description = "My description"
 
   release_notes = {
@@ -29,4 +30,8 @@ resource "distribution_release_bundle_v1" "my-release-bundle-v1" {
       ]
     }]
   }
+
+  timeouts = {
+    create = "30m"
+  }
 }
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "distribution_release_bundle_v1_distribution" "my-release-bundle-v1-distribution" {
+  name    = distribution_release_bundle_v1.my-release-bundle-v1.name
+  version = distribution_release_bundle_v1.my-release-bundle-v1.version
+
+  distribution_rules = [{
+    site_name     = "*"
+    city_name     = "*"
+    country_codes = ["*"]
+  }]
+
+  auto_create_missing_repositories = true
+
+  timeouts = {
+    create = "60m"
+  }
+}
@@ -0,0 +1 @@
+import distribution_release_bundle_v1_signature.my-release-bundle-v1-signature my-release-bundle-v1:1.0.0
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "distribution_release_bundle_v1_signature" "my-release-bundle-v1-signature" {
+  name              = "my-release-bundle-v1"
+  version           = "1.0.0"
+  signing_key_alias = "my-gpg-signing-key"
+  gpg_passphrase    = var.gpg_passphrase
+}
@@ -0,0 +1 @@
+import distribution_signing_key.my-gpg-signing-key gpg:my-gpg-signing-key
\ No newline at end of file
@@ -29,4 +29,20 @@ EOF
   propagate_to_edge_nodes = true
   fail_on_propagation_failure = true
   set_as_default = true
+}
+
+resource "distribution_signing_key" "my-generated-gpg-signing-key" {
+  protocol = "gpg"
+  alias = "my-generated-gpg-signing-key"
+
+  key_generation = {
+    algorithm = "ed25519"
+    name = "Release Signing"
+    email = "release@example.com"
+    expiry_days = 365
+  }
+
+  passphrase = "my-secret-passphrase"
+  propagate_to_edge_nodes = true
+  fail_on_propagation_failure = true
 }
\ No newline at end of file
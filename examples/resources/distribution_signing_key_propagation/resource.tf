@@ -0,0 +1,14 @@
+# Propagate to all Edge Nodes, including the ones registered later
+resource "distribution_signing_key_propagation" "all-edges" {
+  protocol = distribution_signing_key.my-gpg-signing-key.protocol
+  alias    = distribution_signing_key.my-gpg-signing-key.alias
+}
+
+# Propagate to chosen Edge Nodes
+data "distribution_edge_nodes" "all" {}
+
+resource "distribution_signing_key_propagation" "eu-edges" {
+  protocol = distribution_signing_key.my-gpg-signing-key.protocol
+  alias    = distribution_signing_key.my-gpg-signing-key.alias
+  jpd_ids  = [for edge in data.distribution_edge_nodes.all.edge_nodes : edge.id if contains(["DE", "FR"], edge.country_code)]
+}
@@ -0,0 +1 @@
+import distribution_vault_signing_key.my-vault-gpg-signing-key gpg:my-vault-gpg-signing-key
\ No newline at end of file
@@ -0,0 +1,261 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const (
+	JPDsEndpoint = "mc/api/v1/jpds"
+
+	edgeLicenseType = "EDGE"
+)
+
+func NewEdgeNodesDataSource() datasource.DataSource {
+	return &EdgeNodesDataSource{
+		TypeName: "distribution_edge_nodes",
+	}
+}
+
+type EdgeNodesDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type EdgeNodesDataSourceModel struct {
+	EdgeNodes types.List `tfsdk:"edge_nodes"`
+}
+
+type JPDAPIModel struct {
+	ID       string               `json:"id"`
+	Name     string               `json:"name"`
+	URL      string               `json:"url"`
+	Location JPDLocationAPIModel  `json:"location"`
+	Status   JPDStatusAPIModel    `json:"status"`
+	Services []JPDServiceAPIModel `json:"services"`
+	Licenses []JPDLicenseAPIModel `json:"licenses"`
+}
+
+type JPDLocationAPIModel struct {
+	CityName    string `json:"city_name"`
+	CountryCode string `json:"country_code"`
+}
+
+type JPDStatusAPIModel struct {
+	Code string `json:"code"`
+}
+
+type JPDServiceAPIModel struct {
+	ID     string            `json:"id"`
+	Type   string            `json:"type"`
+	Status JPDStatusAPIModel `json:"status"`
+}
+
+type JPDLicenseAPIModel struct {
+	Type         string `json:"type"`
+	Expired      bool   `json:"expired"`
+	ValidThrough string `json:"valid_through"`
+}
+
+// edgeLicense returns the Edge license of the JPD, if it has one.
+func (m JPDAPIModel) edgeLicense() (JPDLicenseAPIModel, bool) {
+	return lo.Find(
+		m.Licenses,
+		func(license JPDLicenseAPIModel) bool {
+			return license.Type == edgeLicenseType
+		},
+	)
+}
+
+// serviceID returns the ID of the Artifactory service of the JPD, which is
+// the ID Distribution reports for distribution targets.
+func (m JPDAPIModel) serviceID() string {
+	service, _ := lo.Find(
+		m.Services,
+		func(service JPDServiceAPIModel) bool {
+			return service.Type == "ARTIFACTORY"
+		},
+	)
+
+	return service.ID
+}
+
+// listEdgeNodes returns the JPDs registered in Mission Control which hold an
+// Edge license.
+func listEdgeNodes(providerData util.ProviderMetadata) ([]JPDAPIModel, error) {
+	var jpds []JPDAPIModel
+
+	response, err := providerData.Client.R().
+		SetResult(&jpds).
+		Get(JPDsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", response.String())
+	}
+
+	return lo.Filter(
+		jpds,
+		func(jpd JPDAPIModel, _ int) bool {
+			_, isEdge := jpd.edgeLicense()
+			return isEdge
+		},
+	), nil
+}
+
+var edgeNodeAttrType = map[string]attr.Type{
+	"id":                    types.StringType,
+	"site_name":             types.StringType,
+	"city_name":             types.StringType,
+	"country_code":          types.StringType,
+	"service_id":            types.StringType,
+	"url":                   types.StringType,
+	"status":                types.StringType,
+	"license_expired":       types.BoolType,
+	"license_valid_through": types.StringType,
+}
+
+var edgeNodeObjectType = types.ObjectType{
+	AttrTypes: edgeNodeAttrType,
+}
+
+func (m *EdgeNodesDataSourceModel) fromAPIModel(_ context.Context, apiModels []JPDAPIModel) (diags diag.Diagnostics) {
+	edgeNodes := lo.Map(
+		apiModels,
+		func(jpd JPDAPIModel, _ int) attr.Value {
+			license, _ := jpd.edgeLicense()
+
+			e, d := types.ObjectValue(
+				edgeNodeAttrType,
+				map[string]attr.Value{
+					"id":                    types.StringValue(jpd.ID),
+					"site_name":             types.StringValue(jpd.Name),
+					"city_name":             types.StringValue(jpd.Location.CityName),
+					"country_code":          types.StringValue(jpd.Location.CountryCode),
+					"service_id":            types.StringValue(jpd.serviceID()),
+					"url":                   types.StringValue(jpd.URL),
+					"status":                types.StringValue(jpd.Status.Code),
+					"license_expired":       types.BoolValue(license.Expired),
+					"license_valid_through": types.StringValue(license.ValidThrough),
+				},
+			)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			return e
+		},
+	)
+
+	edgeNodesList, d := types.ListValue(edgeNodeObjectType, edgeNodes)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+	m.EdgeNodes = edgeNodesList
+
+	return
+}
+
+func (d *EdgeNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *EdgeNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"edge_nodes": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the JFrog Platform Deployment (JPD) of the Edge Node.",
+						},
+						"site_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Site name of the Edge Node, as used in `site_name` of distribution rules and destinations.",
+						},
+						"city_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "City of the Edge Node, as used in `city_name` of distribution rules and destinations.",
+						},
+						"country_code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Country code of the Edge Node, as used in `country_codes` of distribution rules and destinations.",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service ID of the Edge Node Artifactory.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the Edge Node.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the Edge Node, e.g. `ONLINE` or `OFFLINE`.",
+						},
+						"license_expired": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Edge license of the Edge Node is expired.",
+						},
+						"license_valid_through": schema.StringAttribute{
+							Computed:    true,
+							Description: "Expiry date of the Edge license of the Edge Node.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Edge Nodes registered in the JFrog Platform.",
+			},
+		},
+		MarkdownDescription: "Provides a data source to list the Edge Nodes registered in the JFrog Platform. Use it to build distribution rules and permission target destinations from the actual inventory. For more information, see [REST API](https://jfrog.com/help/r/jfrog-rest-apis/get-jpd-list).\n\n" +
+			"~>User must be an admin to list the JFrog Platform Deployments.",
+	}
+}
+
+func (d *EdgeNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *EdgeNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data EdgeNodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	edges, err := listEdgeNodes(d.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, edges)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccEdgeNodesDataSource_full would only be successful when executed against Artifactory instance
+// that has Distribution enabled (i.e. has edge node(s) configured)
+func TestAccEdgeNodesDataSource_full(t *testing.T) {
+	const fqrn = "data.distribution_edge_nodes.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "distribution_edge_nodes" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "edge_nodes.#"),
+					resource.TestCheckResourceAttrSet(fqrn, "edge_nodes.0.id"),
+					resource.TestCheckResourceAttrSet(fqrn, "edge_nodes.0.site_name"),
+					resource.TestCheckResourceAttrSet(fqrn, "edge_nodes.0.service_id"),
+					resource.TestCheckResourceAttrSet(fqrn, "edge_nodes.0.url"),
+					resource.TestCheckResourceAttr(fqrn, "edge_nodes.0.license_expired", "false"),
+				),
+			},
+		},
+	})
+}
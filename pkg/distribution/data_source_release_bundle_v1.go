@@ -0,0 +1,243 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewReleaseBundleV1DataSource() datasource.DataSource {
+	return &ReleaseBundleV1DataSource{
+		TypeName: "distribution_release_bundle_v1",
+	}
+}
+
+type ReleaseBundleV1DataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func (d *ReleaseBundleV1DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV1DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					nameVersionRegexValidator,
+				},
+				Description: "Release bundle name.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					nameVersionRegexValidator,
+				},
+				Description: "Release bundle version.",
+			},
+			"storing_repository": schema.StringAttribute{
+				Computed:    true,
+				Description: "Repository name at source Artifactory where the release bundle artifacts are stored.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the release bundle.",
+			},
+			"release_notes": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"syntax": schema.StringAttribute{
+						Computed:    true,
+						Description: "The syntax for the release notes.",
+					},
+					"content": schema.StringAttribute{
+						Computed:    true,
+						Description: "The content of the release notes.",
+					},
+				},
+				Computed:    true,
+				Description: "Release notes for the release bundle version.",
+			},
+			"spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"queries": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"aql": schema.StringAttribute{
+									Computed:    true,
+									Description: "AQL query for gathering the artifacts from Artifactory.",
+								},
+								"query_name": schema.StringAttribute{
+									Computed:    true,
+									Description: "Name of the query object.",
+								},
+								"added_props": schema.SetNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"key": schema.StringAttribute{
+												Computed: true,
+											},
+											"values": schema.SetAttribute{
+												ElementType: types.StringType,
+												Computed:    true,
+											},
+										},
+									},
+									Computed:    true,
+									Description: "Properties added to the artifacts after distribution of the release bundle.",
+								},
+								"mappings": schema.SetNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"input": schema.StringAttribute{
+												Computed: true,
+											},
+											"output": schema.StringAttribute{
+												Computed: true,
+											},
+										},
+									},
+									Computed:    true,
+									Description: "Mappings applied to the artifact paths after distribution of the release bundle.",
+								},
+								"exclude_props_patterns": schema.SetAttribute{
+									ElementType: types.StringType,
+									Computed:    true,
+									Description: "Patterns for Properties keys excluded after distribution of the release bundle.",
+								},
+							},
+						},
+						Computed:    true,
+						Description: "Query objects the artifacts were gathered by.",
+					},
+				},
+				Computed:    true,
+				Description: "Specification by which artifacts were gathered in this release bundle.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of the release bundle version, e.g. `OPEN`, `SIGNED` or `STORED`.",
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+			},
+			"distributed_by": schema.StringAttribute{
+				Computed: true,
+			},
+			"artifacts": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"checksum": schema.StringAttribute{
+							Computed:    true,
+							Description: "SHA-256 checksum of the artifact.",
+						},
+						"source_repo_path": schema.StringAttribute{
+							Computed: true,
+						},
+						"target_repo_path": schema.StringAttribute{
+							Computed: true,
+						},
+						"props": schema.SetNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Computed: true,
+									},
+									"values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"artifacts_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"archived": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		MarkdownDescription: "Provides a data source to read an existing Release Bundle V1 version. For more information, see [REST API](https://jfrog.com/help/r/jfrog-rest-apis/get-release-bundle-v1-version).",
+	}
+}
+
+func (d *ReleaseBundleV1DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ReleaseBundleV1DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data ReleaseBundleV1CommonModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var releaseBundle ReleaseBundleV1GetAPIModel
+
+	response, err := d.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":    data.Name.ValueString(),
+			"version": data.Version.ValueString(),
+		}).
+		SetQueryParam("format", "json").
+		SetResult(&releaseBundle).
+		Get(ReleaseBundleV1Endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			err.Error(),
+		)
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Release Bundle Not Found",
+			fmt.Sprintf("Release bundle %s version %s does not exist.", data.Name.ValueString(), data.Version.ValueString()),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			response.String(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromGetAPIModel(ctx, releaseBundle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
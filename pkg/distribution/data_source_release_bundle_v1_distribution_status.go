@@ -0,0 +1,305 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+func NewReleaseBundleV1DistributionStatusDataSource() datasource.DataSource {
+	return &ReleaseBundleV1DistributionStatusDataSource{
+		TypeName: "distribution_release_bundle_v1_distribution_status",
+	}
+}
+
+type ReleaseBundleV1DistributionStatusDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV1DistributionStatusDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Version      types.String `tfsdk:"version"`
+	TrackerID    types.String `tfsdk:"tracker_id"`
+	Type         types.String `tfsdk:"type"`
+	Status       types.String `tfsdk:"status"`
+	AllCompleted types.Bool   `tfsdk:"all_completed"`
+	Sites        types.List   `tfsdk:"sites"`
+}
+
+var distributionStatusSiteAttrType = map[string]attr.Type{
+	"name":          types.StringType,
+	"service_id":    types.StringType,
+	"status":        types.StringType,
+	"progress":      types.Float64Type,
+	"error_message": types.StringType,
+}
+
+var distributionStatusSiteObjectType = types.ObjectType{
+	AttrTypes: distributionStatusSiteAttrType,
+}
+
+// progress returns the percentage of bytes distributed to the site.
+func (m ReleaseBundleV1DistributionSiteAPIModel) progress() float64 {
+	if m.TotalBytes > 0 {
+		return float64(m.DistributedBytes) * 100 / float64(m.TotalBytes)
+	}
+
+	if m.Status == distributionStatusCompleted {
+		return 100
+	}
+
+	return 0
+}
+
+func (m ReleaseBundleV1DistributionSiteAPIModel) errorMessage() string {
+	return strings.Join(
+		lo.Compact(append([]string{m.Error}, m.FileErrors...)),
+		"\n",
+	)
+}
+
+func (m *ReleaseBundleV1DistributionStatusDataSourceModel) fromAPIModel(_ context.Context, apiModel ReleaseBundleV1DistributionTrackerAPIModel) (diags diag.Diagnostics) {
+	m.TrackerID = types.StringValue(apiModel.ID.String())
+	m.Type = types.StringValue(apiModel.Type)
+	m.Status = types.StringValue(apiModel.Status)
+	m.AllCompleted = types.BoolValue(distributionTrackerStatus(apiModel) == distributionStatusCompleted)
+
+	sites := lo.Map(
+		apiModel.Sites,
+		func(site ReleaseBundleV1DistributionSiteAPIModel, _ int) attr.Value {
+			s, d := types.ObjectValue(
+				distributionStatusSiteAttrType,
+				map[string]attr.Value{
+					"name":          types.StringValue(site.TargetArtifactory.Name),
+					"service_id":    types.StringValue(site.TargetArtifactory.ServiceID),
+					"status":        types.StringValue(site.Status),
+					"progress":      types.Float64Value(site.progress()),
+					"error_message": types.StringValue(site.errorMessage()),
+				},
+			)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			return s
+		},
+	)
+
+	sitesList, d := types.ListValue(distributionStatusSiteObjectType, sites)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+	m.Sites = sitesList
+
+	return
+}
+
+// latestDistributeTracker returns the distribute tracker with the highest ID.
+func latestDistributeTracker(trackers []ReleaseBundleV1DistributionTrackerAPIModel) (ReleaseBundleV1DistributionTrackerAPIModel, bool) {
+	distributeTrackers := lo.Filter(
+		trackers,
+		func(tracker ReleaseBundleV1DistributionTrackerAPIModel, _ int) bool {
+			return tracker.Type == "distribute"
+		},
+	)
+
+	if len(distributeTrackers) == 0 {
+		return ReleaseBundleV1DistributionTrackerAPIModel{}, false
+	}
+
+	return lo.MaxBy(
+		distributeTrackers,
+		func(a, b ReleaseBundleV1DistributionTrackerAPIModel) bool {
+			idA, _ := a.ID.Int64()
+			idB, _ := b.ID.Int64()
+			return idA > idB
+		},
+	), true
+}
+
+func (d *ReleaseBundleV1DistributionStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV1DistributionStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					nameVersionRegexValidator,
+				},
+				Description: "Release bundle name.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					nameVersionRegexValidator,
+				},
+				Description: "Release bundle version.",
+			},
+			"tracker_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "ID of the distribution tracker to query. If not provided, the most recent distribution of the release bundle version is used.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the tracked operation, e.g. `distribute` or `delete`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Overall status of the distribution as reported by Distribution.",
+			},
+			"all_completed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "`true` when every target Edge Node reports `Completed`.",
+			},
+			"sites": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Edge Node.",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service ID of the Edge Node.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Distribution status on the Edge Node, e.g. `In progress`, `Completed` or `Failed`.",
+						},
+						"progress": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Percentage of the release bundle bytes distributed to the Edge Node.",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Errors reported by the Edge Node, if any.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Distribution status of each target Edge Node.",
+			},
+		},
+		MarkdownDescription: "Provides a data source to query the distribution status of a Release Bundle V1 version on each target Edge Node. For more information, see [REST API](https://jfrog.com/help/r/jfrog-rest-apis/get-distribution-status).",
+	}
+}
+
+func (d *ReleaseBundleV1DistributionStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ReleaseBundleV1DistributionStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data ReleaseBundleV1DistributionStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tracker ReleaseBundleV1DistributionTrackerAPIModel
+
+	if !data.TrackerID.IsNull() {
+		response, err := d.ProviderData.Client.R().
+			SetPathParams(map[string]string{
+				"name":      data.Name.ValueString(),
+				"version":   data.Version.ValueString(),
+				"trackerId": data.TrackerID.ValueString(),
+			}).
+			SetResult(&tracker).
+			Get(ReleaseBundleV1DistributionTrackerEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				err.Error(),
+			)
+			return
+		}
+
+		if response.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Distribution Not Found",
+				fmt.Sprintf("Distribution tracker %s does not exist for release bundle %s version %s.", data.TrackerID.ValueString(), data.Name.ValueString(), data.Version.ValueString()),
+			)
+			return
+		}
+
+		if response.IsError() {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				response.String(),
+			)
+			return
+		}
+	} else {
+		var trackers []ReleaseBundleV1DistributionTrackerAPIModel
+
+		response, err := d.ProviderData.Client.R().
+			SetPathParams(map[string]string{
+				"name":    data.Name.ValueString(),
+				"version": data.Version.ValueString(),
+			}).
+			SetResult(&trackers).
+			Get(ReleaseBundleV1DistributionsEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				err.Error(),
+			)
+			return
+		}
+
+		if response.IsError() && response.StatusCode() != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				response.String(),
+			)
+			return
+		}
+
+		latest, found := latestDistributeTracker(trackers)
+		if !found {
+			resp.Diagnostics.AddError(
+				"Distribution Not Found",
+				fmt.Sprintf("Release bundle %s version %s has not been distributed.", data.Name.ValueString(), data.Version.ValueString()),
+			)
+			return
+		}
+		tracker = latest
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, tracker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,109 @@
+package distribution_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// TestAccReleaseBundleV1DistributionStatusDataSource_full would only be successful when executed against Artifactory instance
+// that has Distribution enabled (i.e. has edge node(s) configured) and a default signing key
+func TestAccReleaseBundleV1DistributionStatusDataSource_full(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-release-bundle-v1-distribution-status", "distribution_release_bundle_v1_distribution")
+	fqrn := "data.distribution_release_bundle_v1_distribution_status." + resourceName
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "1.0.0"
+		sign_immediately = true
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	resource "distribution_release_bundle_v1_distribution" "{{ .name }}" {
+		name = distribution_release_bundle_v1.{{ .name }}.name
+		version = distribution_release_bundle_v1.{{ .name }}.version
+
+		distribution_rules = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+
+		auto_create_missing_repositories = true
+	}
+
+	data "distribution_release_bundle_v1_distribution_status" "{{ .name }}" {
+		name = distribution_release_bundle_v1_distribution.{{ .name }}.name
+		version = distribution_release_bundle_v1_distribution.{{ .name }}.version
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1DistributionStatusDataSource_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fqrn, "tracker_id", "distribution_release_bundle_v1_distribution."+resourceName, "tracker_id"),
+					resource.TestCheckResourceAttr(fqrn, "type", "distribute"),
+					resource.TestCheckResourceAttr(fqrn, "all_completed", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "sites.#"),
+					resource.TestCheckResourceAttrSet(fqrn, "sites.0.name"),
+					resource.TestCheckResourceAttr(fqrn, "sites.0.status", "Completed"),
+					resource.TestCheckResourceAttr(fqrn, "sites.0.progress", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReleaseBundleV1DistributionStatusDataSource_not_distributed(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-release-bundle-v1-distribution-status", "distribution_release_bundle_v1")
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "1.0.0"
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	data "distribution_release_bundle_v1_distribution_status" "{{ .name }}" {
+		name = distribution_release_bundle_v1.{{ .name }}.name
+		version = distribution_release_bundle_v1.{{ .name }}.version
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1DistributionStatusDataSource_not_distributed", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*Distribution Not Found.*"),
+			},
+		},
+	})
+}
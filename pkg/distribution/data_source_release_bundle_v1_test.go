@@ -0,0 +1,86 @@
+package distribution_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccReleaseBundleV1DataSource_full(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-release-bundle-v1", "distribution_release_bundle_v1")
+	fqrn := "data.distribution_release_bundle_v1." + resourceName
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "{{ .version }}"
+		description = "Test description"
+
+		release_notes = {
+			syntax = "plain_text"
+			content = "test release notes"
+		}
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+				query_name: "query-1"
+			}]
+		}
+	}
+
+	data "distribution_release_bundle_v1" "{{ .name }}" {
+		name = distribution_release_bundle_v1.{{ .name }}.name
+		version = distribution_release_bundle_v1.{{ .name }}.version
+	}`
+
+	testData := map[string]string{
+		"name":    resourceName,
+		"version": "1.0.0",
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1DataSource_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "version", testData["version"]),
+					resource.TestCheckResourceAttr(fqrn, "description", "Test description"),
+					resource.TestCheckResourceAttr(fqrn, "release_notes.content", "test release notes"),
+					resource.TestCheckResourceAttr(fqrn, "spec.queries.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "spec.queries.0.query_name", "query-1"),
+					resource.TestCheckResourceAttrSet(fqrn, "state"),
+					resource.TestCheckResourceAttrSet(fqrn, "created"),
+					resource.TestCheckResourceAttrSet(fqrn, "created_by"),
+					resource.TestCheckResourceAttrSet(fqrn, "artifacts.#"),
+					resource.TestCheckResourceAttrSet(fqrn, "artifacts.0.checksum"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReleaseBundleV1DataSource_not_found(t *testing.T) {
+	const config = `
+	data "distribution_release_bundle_v1" "not-found" {
+		name = "non-existent-release-bundle"
+		version = "1.0.0"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Release Bundle Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,310 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const ReleaseBundleV1VersionsEndpoint = ReleaseBundlesV1Endpoint + "/{name}"
+
+func NewReleaseBundleV1VersionsDataSource() datasource.DataSource {
+	return &ReleaseBundleV1VersionsDataSource{
+		TypeName: "distribution_release_bundle_v1_versions",
+	}
+}
+
+type ReleaseBundleV1VersionsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV1VersionsDataSourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	State             types.String `tfsdk:"state"`
+	VersionConstraint types.String `tfsdk:"version_constraint"`
+	OrderBy           types.String `tfsdk:"order_by"`
+	Versions          types.List   `tfsdk:"versions"`
+}
+
+var releaseBundleV1VersionAttrType = map[string]attr.Type{
+	"version":  types.StringType,
+	"state":    types.StringType,
+	"created":  types.StringType,
+	"archived": types.BoolType,
+}
+
+var releaseBundleV1VersionObjectType = types.ObjectType{
+	AttrTypes: releaseBundleV1VersionAttrType,
+}
+
+func (m *ReleaseBundleV1VersionsDataSourceModel) fromAPIModel(_ context.Context, apiModels []ReleaseBundleV1GetAPIModel) (diags diag.Diagnostics) {
+	versions := lo.Map(
+		apiModels,
+		func(releaseBundle ReleaseBundleV1GetAPIModel, _ int) attr.Value {
+			v, d := types.ObjectValue(
+				releaseBundleV1VersionAttrType,
+				map[string]attr.Value{
+					"version":  types.StringValue(releaseBundle.Version),
+					"state":    types.StringValue(releaseBundle.State),
+					"created":  types.StringValue(releaseBundle.Created),
+					"archived": types.BoolValue(releaseBundle.Archived),
+				},
+			)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			return v
+		},
+	)
+
+	versionsList, d := types.ListValue(releaseBundleV1VersionObjectType, versions)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+	m.Versions = versionsList
+
+	return
+}
+
+// filterReleaseBundleV1Versions keeps the release bundle versions matching the
+// state and semantic version constraint filters. Versions which are not valid
+// semantic versions never match a version constraint.
+func filterReleaseBundleV1Versions(releaseBundles []ReleaseBundleV1GetAPIModel, state string, constraints version.Constraints) []ReleaseBundleV1GetAPIModel {
+	return lo.Filter(
+		releaseBundles,
+		func(releaseBundle ReleaseBundleV1GetAPIModel, _ int) bool {
+			if state != "" && releaseBundle.State != state {
+				return false
+			}
+
+			if constraints != nil {
+				v, err := version.NewVersion(releaseBundle.Version)
+				if err != nil {
+					return false
+				}
+				return constraints.Check(v)
+			}
+
+			return true
+		},
+	)
+}
+
+// sortReleaseBundleV1Versions orders the release bundle versions from newest to
+// oldest, either by creation date or by semantic version. When ordering by
+// version, versions which are not valid semantic versions are placed last,
+// ordered by creation date.
+func sortReleaseBundleV1Versions(releaseBundles []ReleaseBundleV1GetAPIModel, orderBy string) {
+	sort.SliceStable(releaseBundles, func(i, j int) bool {
+		if orderBy == "version" {
+			vi, errI := version.NewVersion(releaseBundles[i].Version)
+			vj, errJ := version.NewVersion(releaseBundles[j].Version)
+
+			switch {
+			case errI == nil && errJ == nil:
+				if !vi.Equal(vj) {
+					return vi.GreaterThan(vj)
+				}
+			case errI == nil:
+				return true
+			case errJ == nil:
+				return false
+			}
+		}
+
+		return createdAfter(releaseBundles[i].Created, releaseBundles[j].Created)
+	})
+}
+
+// createdLayouts are the layouts of the creation dates returned by
+// Distribution, which uses RFC 3339 offsets such as "+00:00" or offsets
+// without a colon such as "+0000" depending on its version. Fractional
+// seconds are accepted by both layouts.
+var createdLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+}
+
+// parseCreated parses a creation date in any of createdLayouts.
+func parseCreated(created string) (time.Time, error) {
+	var err error
+	for _, layout := range createdLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, created); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// createdAfter returns whether creation date a is after creation date b. Dates
+// are compared as timestamps, so different time zone offsets and fractional
+// seconds are ordered correctly. Dates which cannot be parsed are placed last
+// and compared as strings.
+func createdAfter(a, b string) bool {
+	ta, errA := parseCreated(a)
+	tb, errB := parseCreated(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return ta.After(tb)
+	case errA == nil:
+		return true
+	case errB == nil:
+		return false
+	}
+
+	return a > b
+}
+
+func (d *ReleaseBundleV1VersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ReleaseBundleV1VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					nameVersionRegexValidator,
+				},
+				Description: "Release bundle name.",
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(releaseBundleV1States...),
+				},
+				MarkdownDescription: "Only return versions in this state. Valid values: `OPEN`, `SIGNED`, `STORED`, `READY_FOR_DISTRIBUTION`.",
+			},
+			"version_constraint": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Only return versions matching this semantic version constraint, e.g. `>= 1.2.0, < 2.0.0`. Versions which are not valid semantic versions are excluded when set.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("created", "version"),
+				},
+				MarkdownDescription: "Order of the returned versions, newest first. `created` (default) orders by creation date. `version` orders by semantic version, with versions which are not valid semantic versions last.",
+			},
+			"versions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Release bundle version.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "State of the release bundle version.",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date of the release bundle version.",
+						},
+						"archived": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the release bundle version is archived.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Matching release bundle versions, newest first.",
+			},
+		},
+		MarkdownDescription: "Provides a data source to list the versions of a Release Bundle V1. For more information, see [REST API](https://jfrog.com/help/r/jfrog-rest-apis/get-release-bundle-v1-versions).",
+	}
+}
+
+func (d *ReleaseBundleV1VersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ReleaseBundleV1VersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data ReleaseBundleV1VersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var constraints version.Constraints
+	if !data.VersionConstraint.IsNull() {
+		c, err := version.NewConstraint(data.VersionConstraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version_constraint"),
+				"Invalid Version Constraint",
+				err.Error(),
+			)
+			return
+		}
+		constraints = c
+	}
+
+	var releaseBundles []ReleaseBundleV1GetAPIModel
+
+	response, err := d.ProviderData.Client.R().
+		SetPathParam("name", data.Name.ValueString()).
+		SetQueryParam("format", "json").
+		SetResult(&releaseBundles).
+		Get(ReleaseBundleV1VersionsEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			err.Error(),
+		)
+		return
+	}
+
+	// A release bundle without any version does not exist, which is not an
+	// error when listing its versions.
+	if response.StatusCode() != http.StatusNotFound && response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			response.String(),
+		)
+		return
+	}
+
+	releaseBundles = filterReleaseBundleV1Versions(releaseBundles, data.State.ValueString(), constraints)
+	sortReleaseBundleV1Versions(releaseBundles, data.OrderBy.ValueString())
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, releaseBundles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
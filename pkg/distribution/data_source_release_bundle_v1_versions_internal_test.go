@@ -0,0 +1,181 @@
+package distribution
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/samber/lo"
+)
+
+func releaseBundleV1Versions(releaseBundles []ReleaseBundleV1GetAPIModel) []string {
+	return lo.Map(releaseBundles, func(releaseBundle ReleaseBundleV1GetAPIModel, _ int) string {
+		return releaseBundle.Version
+	})
+}
+
+func TestFilterReleaseBundleV1Versions(t *testing.T) {
+	releaseBundles := []ReleaseBundleV1GetAPIModel{
+		{Version: "1.0.0", State: "SIGNED"},
+		{Version: "1.1.0", State: "OPEN"},
+		{Version: "2.0.0", State: "SIGNED"},
+		{Version: "latest", State: "SIGNED"},
+	}
+
+	constraints, err := version.NewConstraint(">= 1.1, < 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		state       string
+		constraints version.Constraints
+		expected    []string
+	}{
+		{name: "no filter", expected: []string{"1.0.0", "1.1.0", "2.0.0", "latest"}},
+		{name: "state", state: "SIGNED", expected: []string{"1.0.0", "2.0.0", "latest"}},
+		{name: "constraints", constraints: constraints, expected: []string{"1.1.0", "2.0.0"}},
+		{name: "state and constraints", state: "SIGNED", constraints: constraints, expected: []string{"2.0.0"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := releaseBundleV1Versions(filterReleaseBundleV1Versions(releaseBundles, tc.state, tc.constraints))
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSortReleaseBundleV1Versions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		orderBy        string
+		releaseBundles []ReleaseBundleV1GetAPIModel
+		expected       []string
+	}{
+		{
+			name:    "created with time zone offsets",
+			orderBy: "created",
+			releaseBundles: []ReleaseBundleV1GetAPIModel{
+				{Version: "a", Created: "2024-01-01T10:00:00Z"},
+				{Version: "b", Created: "2024-01-01T11:30:00+02:00"},
+				{Version: "c", Created: "2024-01-01T09:00:00-02:00"},
+			},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name:    "created with fractional seconds",
+			orderBy: "created",
+			releaseBundles: []ReleaseBundleV1GetAPIModel{
+				{Version: "a", Created: "2024-01-01T10:00:00Z"},
+				{Version: "b", Created: "2024-01-01T10:00:00.500Z"},
+				{Version: "c", Created: "2024-01-01T10:00:00.05Z"},
+			},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name:    "created without colon in the offset",
+			orderBy: "created",
+			releaseBundles: []ReleaseBundleV1GetAPIModel{
+				{Version: "a", Created: "2024-01-01T10:00:00.000+0000"},
+				{Version: "b", Created: "2024-01-01T11:30:00.000+0200"},
+				{Version: "c", Created: "2024-01-01T09:00:00.000-0200"},
+				{Version: "d", Created: "2024-01-01T10:30:00.000Z"},
+			},
+			expected: []string{"c", "d", "a", "b"},
+		},
+		{
+			name:    "created with invalid dates last",
+			orderBy: "created",
+			releaseBundles: []ReleaseBundleV1GetAPIModel{
+				{Version: "a", Created: "invalid"},
+				{Version: "b", Created: "2024-01-01T10:00:00Z"},
+			},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:    "version",
+			orderBy: "version",
+			releaseBundles: []ReleaseBundleV1GetAPIModel{
+				{Version: "latest", Created: "2024-01-03T10:00:00Z"},
+				{Version: "1.10.0", Created: "2024-01-01T10:00:00Z"},
+				{Version: "nightly", Created: "2024-01-04T10:00:00Z"},
+				{Version: "1.9.0", Created: "2024-01-02T10:00:00Z"},
+			},
+			expected: []string{"1.10.0", "1.9.0", "nightly", "latest"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sortReleaseBundleV1Versions(tc.releaseBundles, tc.orderBy)
+			actual := releaseBundleV1Versions(tc.releaseBundles)
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSortReleaseBundleV1Versions_api_response(t *testing.T) {
+	// Release bundle versions as returned by Distribution, which formats
+	// creation dates with milliseconds and an offset without a colon.
+	const response = `[
+		{
+			"name": "my-bundle",
+			"version": "1.0.1",
+			"state": "SIGNED",
+			"description": "",
+			"release_notes": {"syntax": "plain_text", "content": ""},
+			"created": "2024-03-05T16:12:41.312+0000",
+			"created_by": "admin",
+			"artifacts": [],
+			"artifacts_size": 0,
+			"archived": false,
+			"spec": {"queries": []}
+		},
+		{
+			"name": "my-bundle",
+			"version": "1.0.0",
+			"state": "DISTRIBUTED",
+			"description": "",
+			"release_notes": {"syntax": "plain_text", "content": ""},
+			"created": "2024-03-05T18:02:09.874+0200",
+			"created_by": "admin",
+			"distributed_by": "admin",
+			"artifacts": [],
+			"artifacts_size": 0,
+			"archived": false,
+			"spec": {"queries": []}
+		},
+		{
+			"name": "my-bundle",
+			"version": "1.1.0",
+			"state": "OPEN",
+			"description": "",
+			"release_notes": {"syntax": "plain_text", "content": ""},
+			"created": "2024-03-06T08:45:00.007Z",
+			"created_by": "admin",
+			"artifacts": [],
+			"artifacts_size": 0,
+			"archived": false,
+			"spec": {"queries": []}
+		}
+	]`
+
+	var releaseBundles []ReleaseBundleV1GetAPIModel
+	if err := json.Unmarshal([]byte(response), &releaseBundles); err != nil {
+		t.Fatal(err)
+	}
+
+	sortReleaseBundleV1Versions(releaseBundles, "created")
+
+	expected := []string{"1.1.0", "1.0.1", "1.0.0"}
+	if actual := releaseBundleV1Versions(releaseBundles); !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
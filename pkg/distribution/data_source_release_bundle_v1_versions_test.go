@@ -0,0 +1,84 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccReleaseBundleV1VersionsDataSource_full(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-release-bundle-v1", "distribution_release_bundle_v1")
+	fqrn := "data.distribution_release_bundle_v1_versions." + resourceName
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}-1" {
+		name = "{{ .name }}"
+		version = "1.2.0"
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	resource "distribution_release_bundle_v1" "{{ .name }}-2" {
+		name = "{{ .name }}"
+		version = "1.10.0"
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	resource "distribution_release_bundle_v1" "{{ .name }}-3" {
+		name = "{{ .name }}"
+		version = "2.0.0"
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	data "distribution_release_bundle_v1_versions" "{{ .name }}" {
+		name = "{{ .name }}"
+		state = "OPEN"
+		version_constraint = "< 2.0.0"
+		order_by = "version"
+
+		depends_on = [
+			distribution_release_bundle_v1.{{ .name }}-1,
+			distribution_release_bundle_v1.{{ .name }}-2,
+			distribution_release_bundle_v1.{{ .name }}-3,
+		]
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1VersionsDataSource_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "versions.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0.version", "1.10.0"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0.state", "OPEN"),
+					resource.TestCheckResourceAttrSet(fqrn, "versions.0.created"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0.archived", "false"),
+					resource.TestCheckResourceAttr(fqrn, "versions.1.version", "1.2.0"),
+				),
+			},
+		},
+	})
+}
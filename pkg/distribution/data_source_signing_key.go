@@ -0,0 +1,195 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+func NewSigningKeyDataSource() datasource.DataSource {
+	return &SigningKeyDataSource{
+		TypeName: "distribution_signing_key",
+	}
+}
+
+type SigningKeyDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SigningKeyDataSourceModel struct {
+	Protocol    types.String `tfsdk:"protocol"`
+	Alias       types.String `tfsdk:"alias"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+func (m *SigningKeyDataSourceModel) fromAPIModel(_ context.Context, apiModel SigningKeyGetAPIModel) (diags diag.Diagnostics) {
+	m.Alias = types.StringValue(apiModel.Alias)
+	m.PublicKey = types.StringValue(apiModel.PublicKey)
+	m.Fingerprint = types.StringNull()
+	m.IsDefault = types.BoolValue(apiModel.IsDefault)
+
+	// A public key which cannot be parsed does not prevent reading the
+	// signing key, only its fingerprint is left null.
+	fingerprint, err := publicKeyFingerprint(apiModel.PublicKey)
+	if err != nil {
+		diags.AddWarning(
+			"Invalid Public Key",
+			fmt.Sprintf("Unable to parse public key of signing key %s, fingerprint is not set: %s", apiModel.Alias, err),
+		)
+		return
+	}
+	m.Fingerprint = types.StringValue(fingerprint)
+
+	return
+}
+
+func (d *SigningKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *SigningKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gpg", "pgp"),
+				},
+				MarkdownDescription: "Type of the signing key. Valid value: `gpg` or `pgp`",
+			},
+			"alias": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Alias of the signing key. If not provided, the default signing key is returned.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fingerprint of the public key, or null if the public key cannot be parsed",
+			},
+			"is_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the signing key is the default signing key",
+			},
+		},
+		MarkdownDescription: "Provides a data source to read a signing key uploaded to Distribution, or the default signing key. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).",
+	}
+}
+
+func (d *SigningKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *SigningKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data SigningKeyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var signingKey SigningKeyGetAPIModel
+
+	if !data.Alias.IsNull() {
+		response, err := d.ProviderData.Client.R().
+			SetPathParams(map[string]string{
+				"protocol": data.Protocol.ValueString(),
+				"alias":    data.Alias.ValueString(),
+			}).
+			SetResult(&signingKey).
+			Get(SigningKeyEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				err.Error(),
+			)
+			return
+		}
+
+		if response.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Signing Key Not Found",
+				fmt.Sprintf("Signing key %s does not exist for protocol %s.", data.Alias.ValueString(), data.Protocol.ValueString()),
+			)
+			return
+		}
+
+		if response.IsError() {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				response.String(),
+			)
+			return
+		}
+	} else {
+		var signingKeys []SigningKeyGetAPIModel
+
+		response, err := d.ProviderData.Client.R().
+			SetPathParam("protocol", data.Protocol.ValueString()).
+			SetResult(&signingKeys).
+			Get(SigningKeysEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				err.Error(),
+			)
+			return
+		}
+
+		if response.IsError() {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				response.String(),
+			)
+			return
+		}
+
+		defaultKey, found := lo.Find(
+			signingKeys,
+			func(signingKey SigningKeyGetAPIModel) bool {
+				return signingKey.IsDefault
+			},
+		)
+		if !found {
+			resp.Diagnostics.AddError(
+				"Signing Key Not Found",
+				fmt.Sprintf("No default signing key is set for protocol %s.", data.Protocol.ValueString()),
+			)
+			return
+		}
+		signingKey = defaultKey
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, signingKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,189 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+func NewSigningKeysDataSource() datasource.DataSource {
+	return &SigningKeysDataSource{
+		TypeName: "distribution_signing_keys",
+	}
+}
+
+type SigningKeysDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SigningKeysDataSourceModel struct {
+	Protocol     types.String `tfsdk:"protocol"`
+	DefaultAlias types.String `tfsdk:"default_alias"`
+	Keys         types.List   `tfsdk:"keys"`
+}
+
+var signingKeyAttrType = map[string]attr.Type{
+	"alias":       types.StringType,
+	"public_key":  types.StringType,
+	"fingerprint": types.StringType,
+	"is_default":  types.BoolType,
+}
+
+var signingKeyObjectType = types.ObjectType{
+	AttrTypes: signingKeyAttrType,
+}
+
+func (m *SigningKeysDataSourceModel) fromAPIModel(_ context.Context, apiModels []SigningKeyGetAPIModel) (diags diag.Diagnostics) {
+	m.DefaultAlias = types.StringNull()
+
+	keys := lo.Map(
+		apiModels,
+		func(signingKey SigningKeyGetAPIModel, _ int) attr.Value {
+			if signingKey.IsDefault {
+				m.DefaultAlias = types.StringValue(signingKey.Alias)
+			}
+
+			// A public key which cannot be parsed does not prevent listing
+			// the signing keys, only its fingerprint is left null.
+			fingerprint := types.StringNull()
+			if f, err := publicKeyFingerprint(signingKey.PublicKey); err != nil {
+				diags.AddWarning(
+					"Invalid Public Key",
+					fmt.Sprintf("Unable to parse public key of signing key %s, fingerprint is not set: %s", signingKey.Alias, err),
+				)
+			} else {
+				fingerprint = types.StringValue(f)
+			}
+
+			k, d := types.ObjectValue(
+				signingKeyAttrType,
+				map[string]attr.Value{
+					"alias":       types.StringValue(signingKey.Alias),
+					"public_key":  types.StringValue(signingKey.PublicKey),
+					"fingerprint": fingerprint,
+					"is_default":  types.BoolValue(signingKey.IsDefault),
+				},
+			)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			return k
+		},
+	)
+
+	keysList, d := types.ListValue(signingKeyObjectType, keys)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+	m.Keys = keysList
+
+	return
+}
+
+func (d *SigningKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *SigningKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gpg", "pgp"),
+				},
+				MarkdownDescription: "Type of the signing keys. Valid value: `gpg` or `pgp`",
+			},
+			"default_alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "Alias of the default signing key, if any.",
+			},
+			"keys": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							Computed:    true,
+							Description: "Alias of the signing key",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key",
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed:    true,
+							Description: "Fingerprint of the public key, or null if the public key cannot be parsed",
+						},
+						"is_default": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the signing key is the default signing key",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Signing keys of the protocol.",
+			},
+		},
+		MarkdownDescription: "Provides a data source to list the signing keys uploaded to Distribution. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).",
+	}
+}
+
+func (d *SigningKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *SigningKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data SigningKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var signingKeys []SigningKeyGetAPIModel
+
+	response, err := d.ProviderData.Client.R().
+		SetPathParam("protocol", data.Protocol.ValueString()).
+		SetResult(&signingKeys).
+		Get(SigningKeysEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			response.String(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, signingKeys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
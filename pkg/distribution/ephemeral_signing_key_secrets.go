@@ -0,0 +1,214 @@
+package distribution
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewSigningKeySecretsEphemeralResource() ephemeral.EphemeralResource {
+	return &SigningKeySecretsEphemeralResource{
+		TypeName: "distribution_signing_key_secrets",
+	}
+}
+
+type SigningKeySecretsEphemeralResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SigningKeySecretsEphemeralResourceModel struct {
+	PrivateKeyPath types.String `tfsdk:"private_key_path"`
+	PrivateKeyEnv  types.String `tfsdk:"private_key_env"`
+	PassphraseEnv  types.String `tfsdk:"passphrase_env"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	Passphrase     types.String `tfsdk:"passphrase"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+}
+
+func (e *SigningKeySecretsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = e.TypeName
+}
+
+func (e *SigningKeySecretsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"private_key_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key_env")),
+				},
+				Description: "Path of the file holding the ASCII armored private key.",
+			},
+			"private_key_env": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the environment variable holding the ASCII armored private key.",
+			},
+			"passphrase_env": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the environment variable holding the passphrase of the private key.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "ASCII armored private key.",
+			},
+			"passphrase": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Passphrase of the private key.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "ASCII armored public key of the private key.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Fingerprint of the public key.",
+			},
+		},
+		MarkdownDescription: "Provides an ephemeral resource to read a signing key and its passphrase from a file or environment variables, without storing them in the Terraform state or plan. An error is reported if the passphrase does not decrypt the private key, and only a warning if the private key checksum fails, as Distribution accepts such keys. Use it with the write-only `private_key_wo` and `passphrase_wo` attributes of `distribution_signing_key`, or `gpg_passphrase_wo` of the release bundle resources.\n\n" +
+			"->Ephemeral resources require Terraform 1.10 or later. Write-only attributes require Terraform 1.11 or later.",
+	}
+}
+
+func (e *SigningKeySecretsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	e.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (e *SigningKeySecretsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	go util.SendUsage(ctx, e.ProviderData.Client.R(), e.ProviderData.ProductId, fmt.Sprintf("EphemeralResource/%s/OPEN", e.TypeName))
+
+	var data SigningKeySecretsEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var armoredPrivateKey string
+	if !data.PrivateKeyPath.IsNull() {
+		content, err := os.ReadFile(data.PrivateKeyPath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_path"),
+				"Unable to Read Private Key",
+				err.Error(),
+			)
+			return
+		}
+		armoredPrivateKey = string(content)
+	} else {
+		value, found := os.LookupEnv(data.PrivateKeyEnv.ValueString())
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_env"),
+				"Unable to Read Private Key",
+				fmt.Sprintf("Environment variable %s is not set.", data.PrivateKeyEnv.ValueString()),
+			)
+			return
+		}
+		armoredPrivateKey = value
+	}
+
+	passphrase := types.StringNull()
+	if !data.PassphraseEnv.IsNull() {
+		value, found := os.LookupEnv(data.PassphraseEnv.ValueString())
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("passphrase_env"),
+				"Unable to Read Passphrase",
+				fmt.Sprintf("Environment variable %s is not set.", data.PassphraseEnv.ValueString()),
+			)
+			return
+		}
+		passphrase = types.StringValue(value)
+	}
+
+	entity, err := readArmoredKey(armoredPrivateKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Private Key",
+			fmt.Sprintf("Failed to parse private key: %s", err),
+		)
+		return
+	}
+
+	if entity.PrivateKey == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Private Key",
+			"The private key does not contain private key material.",
+		)
+		return
+	}
+
+	// The public key is serialized before the private key is decrypted, so
+	// the check below does not change it.
+	var publicKey bytes.Buffer
+	if err := armorEncode(&publicKey, openpgp.PublicKeyType, entity.Serialize); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Private Key",
+			fmt.Sprintf("Failed to serialize public key: %s", err),
+		)
+		return
+	}
+
+	if entity.PrivateKey.Encrypted {
+		if passphrase.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("passphrase_env"),
+				"Missing Passphrase",
+				"The private key is encrypted. Set passphrase_env to decrypt it.",
+			)
+			return
+		}
+
+		if err := entity.DecryptPrivateKeys([]byte(passphrase.ValueString())); err != nil {
+			if !isPrivateKeyChecksumFailure(err) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("passphrase_env"),
+					"Invalid Passphrase",
+					fmt.Sprintf("The passphrase does not decrypt the private key: %s", err),
+				)
+				return
+			}
+
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("passphrase_env"),
+				"Invalid Passphrase",
+				fmt.Sprintf("The passphrase may not decrypt the private key, Distribution rejects the signing key if it does not: %s", err),
+			)
+		}
+	}
+
+	data.PrivateKey = types.StringValue(armoredPrivateKey)
+	data.Passphrase = passphrase
+	data.PublicKey = types.StringValue(publicKey.String())
+	data.Fingerprint = types.StringValue(fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint))
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
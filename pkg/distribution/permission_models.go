@@ -3,17 +3,25 @@ package distribution
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
 )
 
 // API Endpoints
@@ -29,11 +37,28 @@ type DistributionDestination struct {
 	CountryCodes []string `tfsdk:"country_codes" json:"country_codes"`
 }
 
+// PermissionReleaseBundle matches the JSON structure for release_bundle
+type PermissionReleaseBundle struct {
+	IncludePatterns []string `tfsdk:"include_patterns" json:"include_patterns"`
+	ExcludePatterns []string `tfsdk:"exclude_patterns" json:"exclude_patterns,omitempty"`
+}
+
+var permissionReleaseBundleAttrTypes = map[string]attr.Type{
+	"include_patterns": types.ListType{ElemType: types.StringType},
+	"exclude_patterns": types.ListType{ElemType: types.StringType},
+}
+
+const (
+	permissionResourceTypeDestination   = "destination"
+	permissionResourceTypeReleaseBundle = "release_bundle"
+)
+
 // Permission Models
 type PermissionResourceModel struct {
 	Name                     types.String `tfsdk:"name"`
 	ResourceType             types.String `tfsdk:"resource_type"`
 	DistributionDestinations types.List   `tfsdk:"distribution_destinations"`
+	ReleaseBundle            types.Object `tfsdk:"release_bundle"`
 	Principals               types.Object `tfsdk:"principals"`
 }
 
@@ -41,6 +66,7 @@ type PermissionAPIModel struct {
 	Name                     string                    `json:"name"`
 	ResourceType             string                    `json:"resource_type"`
 	DistributionDestinations []DistributionDestination `json:"distribution_destinations"`
+	ReleaseBundle            *PermissionReleaseBundle  `json:"release_bundle,omitempty"`
 	Principals               PermissionPrincipals      `json:"principals"`
 }
 
@@ -49,6 +75,148 @@ type PermissionPrincipals struct {
 	Groups map[string][]string `tfsdk:"groups" json:"groups,omitempty"`
 }
 
+// permissionActions maps the long form of each permission action to the code
+// used by the API.
+var permissionActions = map[string]string{
+	"read":       "r",
+	"annotate":   "n",
+	"distribute": "x",
+	"delete":     "d",
+	"manage":     "m",
+}
+
+// validPermissionActions returns the codes and long forms of the permission
+// actions.
+func validPermissionActions() []string {
+	actions := append(lo.Values(permissionActions), lo.Keys(permissionActions)...)
+	sort.Strings(actions)
+	return actions
+}
+
+// permissionActionCodes returns the sorted API codes of the actions.
+func permissionActionCodes(actions []string) []string {
+	codes := lo.Uniq(lo.Map(actions, func(action string, _ int) string {
+		if code, ok := permissionActions[action]; ok {
+			return code
+		}
+		return action
+	}))
+	sort.Strings(codes)
+	return codes
+}
+
+// sameActions returns whether both lists grant the same permissions.
+func sameActions(a, b []string) bool {
+	return slices.Equal(permissionActionCodes(a), permissionActionCodes(b))
+}
+
+// permissionActionsType is the type of the actions granted to a user or group.
+// Actions granting the same permissions are semantically equal, so the sorted
+// codes returned by the API do not replace long-form actions or their order.
+type permissionActionsType struct {
+	basetypes.ListType
+}
+
+var _ basetypes.ListTypable = permissionActionsType{}
+
+var permissionActionsListType = permissionActionsType{
+	ListType: basetypes.ListType{ElemType: types.StringType},
+}
+
+func (t permissionActionsType) Equal(o attr.Type) bool {
+	other, ok := o.(permissionActionsType)
+	return ok && t.ListType.Equal(other.ListType)
+}
+
+func (t permissionActionsType) String() string {
+	return "permissionActionsType"
+}
+
+func (t permissionActionsType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return permissionActionsValue{ListValue: in}, nil
+}
+
+func (t permissionActionsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := value.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+
+	return permissionActionsValue{ListValue: listValue}, nil
+}
+
+func (t permissionActionsType) ValueType(_ context.Context) attr.Value {
+	return permissionActionsValue{}
+}
+
+type permissionActionsValue struct {
+	basetypes.ListValue
+}
+
+var _ basetypes.ListValuableWithSemanticEquals = permissionActionsValue{}
+
+func (v permissionActionsValue) Equal(o attr.Value) bool {
+	other, ok := o.(permissionActionsValue)
+	return ok && v.ListValue.Equal(other.ListValue)
+}
+
+func (v permissionActionsValue) Type(_ context.Context) attr.Type {
+	return permissionActionsListType
+}
+
+// ListSemanticEquals returns whether both actions grant the same permissions.
+func (v permissionActionsValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(permissionActionsValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var actions, newActions []string
+	diags.Append(v.ElementsAs(ctx, &actions, false)...)
+	diags.Append(newValue.ElementsAs(ctx, &newActions, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return sameActions(actions, newActions), diags
+}
+
+// changedPrincipals returns the users and groups of expected which are not
+// granted the same permissions in actual.
+func changedPrincipals(expected, actual PermissionPrincipals) []string {
+	changed := func(principalType string, expected, actual map[string][]string) []string {
+		names := lo.Filter(lo.Keys(expected), func(name string, _ int) bool {
+			actions, ok := actual[name]
+			return !ok || !sameActions(expected[name], actions)
+		})
+		sort.Strings(names)
+		return lo.Map(names, func(name string, _ int) string {
+			return fmt.Sprintf("%s %s", principalType, name)
+		})
+	}
+
+	return append(
+		changed(permissionPrincipalTypeUser, expected.Users, actual.Users),
+		changed(permissionPrincipalTypeGroup, expected.Groups, actual.Groups)...,
+	)
+}
+
+var permissionPrincipalsAttrTypes = map[string]attr.Type{
+	"users":  types.MapType{ElemType: permissionActionsListType},
+	"groups": types.MapType{ElemType: permissionActionsListType},
+}
+
 // Permission Schema Attributes
 var permissionSchemaAttributes = map[string]schema.Attribute{
 	"name": schema.StringAttribute{
@@ -64,25 +232,30 @@ var permissionSchemaAttributes = map[string]schema.Attribute{
 	"resource_type": schema.StringAttribute{
 		Required: true,
 		Validators: []validator.String{
-			validateResourceType(), // Only "destination" is allowed
+			validateResourceType(), // "destination" or "release_bundle"
 		},
-		Description: "Resource type for the permission (only 'destination' is allowed)",
+		MarkdownDescription: "Resource type for the permission. Valid values: `destination` or `release_bundle`. With `release_bundle`, the permission only applies to the release bundles matching `release_bundle`, distributed to `distribution_destinations`.",
 	},
 	"distribution_destinations": schema.ListNestedAttribute{
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				"site_name": schema.StringAttribute{
-					Required:    true,
-					Description: "Site name for the distribution destination",
+					Required:            true,
+					MarkdownDescription: "Site name for the distribution destination, or `*` for all sites",
 				},
 				"city_name": schema.StringAttribute{
-					Required:    true,
-					Description: "City name for the distribution destination",
+					Required:            true,
+					MarkdownDescription: "City name for the distribution destination, or `*` for all cities",
 				},
 				"country_codes": schema.ListAttribute{
 					ElementType: types.StringType,
 					Required:    true,
-					Description: "Country codes for the distribution destination",
+					Validators: []validator.List{
+						validateUniqueCountryCodes(),
+						listvalidator.ValueStringsAre(validateCountryCode()),
+						validateCountryCodesWildcard(),
+					},
+					MarkdownDescription: "ISO 3166-1 alpha-2 country codes for the distribution destination, e.g. `US`, or `[\"*\"]` for all countries",
 				},
 			},
 			Validators: []validator.Object{
@@ -92,28 +265,58 @@ var permissionSchemaAttributes = map[string]schema.Attribute{
 		Required: true, // Change from Optional to Required
 		Validators: []validator.List{
 			validateDistributionDestinations(), // At least one destination required
+			validateUniqueDestinations(),       // No duplicate site_name and city_name
+		},
+		Description: "Distribution destinations for the permission (at least one required). When the destinations are created or changed, a warning is reported during plan for each destination which matches no registered Edge Node.",
+	},
+	"release_bundle": schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"include_patterns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				MarkdownDescription: "Patterns of the release bundle names the permission applies to, e.g. `app-*`",
+			},
+			"exclude_patterns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				Description: "Patterns of the release bundle names excluded from the permission",
+			},
 		},
-		Description: "Distribution destinations for the permission (at least one required)",
+		Optional:            true,
+		MarkdownDescription: "Release bundles the permission applies to. Required when `resource_type` is `release_bundle`, not allowed otherwise.",
 	},
 	"principals": schema.SingleNestedAttribute{
 		Attributes: map[string]schema.Attribute{
 			"users": schema.MapAttribute{
-				ElementType: types.ListType{ElemType: types.StringType},
+				ElementType: permissionActionsListType,
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Map{
+					validatePermissionActions(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					planModifierForEmptyMap{},
 				},
-				Description: "User principals for the permission",
+				MarkdownDescription: "User principals for the permission, with the actions granted to each user. Valid actions: `r` or `read`, `n` or `annotate`, `x` or `distribute`, `d` or `delete`, and `m` or `manage`.",
 			},
 			"groups": schema.MapAttribute{
-				ElementType: types.ListType{ElemType: types.StringType},
+				ElementType: permissionActionsListType,
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Map{
+					validatePermissionActions(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					planModifierForEmptyMap{},
 				},
-				Description: "Group principals for the permission",
+				MarkdownDescription: "Group principals for the permission, with the actions granted to each group. Valid actions: `r` or `read`, `n` or `annotate`, `x` or `distribute`, `d` or `delete`, and `m` or `manage`.",
 			},
 		},
 		Required: true, // Change from Optional to Required
@@ -135,11 +338,30 @@ func (m PermissionErrorAPIModel) String() string {
 	return fmt.Sprintf("%d - %s: %s", m.StatusCode, m.Message, m.Detail)
 }
 
+// errorMessage returns the error decoded from the response, or the response
+// body if it is not a permission error.
+func (m PermissionErrorAPIModel) errorMessage(responseBody string) string {
+	if m.Message == "" && m.Detail == "" {
+		return responseBody
+	}
+
+	return m.String()
+}
+
 // Custom validators
 
-// validateResourceType ensures only "destination" is allowed
+// validateResourceType ensures only "destination" or "release_bundle" is allowed
 func validateResourceType() validator.String {
-	return stringvalidator.OneOf("destination")
+	return stringvalidator.OneOf(permissionResourceTypeDestination, permissionResourceTypeReleaseBundle)
+}
+
+// validatePermissionActions ensures only known actions are granted to principals
+func validatePermissionActions() validator.Map {
+	return mapvalidator.ValueListsAre(
+		listvalidator.ValueStringsAre(
+			stringvalidator.OneOf(validPermissionActions()...),
+		),
+	)
 }
 
 // validatePrincipals ensures at least one user or group is provided
@@ -164,6 +386,161 @@ func validateDistributionDestination() validator.Object {
 	)
 }
 
+// validateCountryCode ensures a country code is an ISO 3166-1 alpha-2 code or "*"
+func validateCountryCode() validator.String {
+	return countryCodeValidator{}
+}
+
+type countryCodeValidator struct{}
+
+func (v countryCodeValidator) Description(ctx context.Context) string {
+	return "value must be an ISO 3166-1 alpha-2 country code or *"
+}
+
+func (v countryCodeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an ISO 3166-1 alpha-2 country code or `*`"
+}
+
+func (v countryCodeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Lower case codes were accepted before they were validated, so the case
+	// is ignored.
+	countryCode := req.ConfigValue.ValueString()
+	if countryCode == "*" || lo.Contains(iso3166Alpha2CountryCodes, strings.ToUpper(countryCode)) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Country Code",
+		fmt.Sprintf("%q is not an ISO 3166-1 alpha-2 country code. Use a code such as US or DE, or * for all countries.", countryCode),
+	)
+}
+
+// validateCountryCodesWildcard ensures "*" is not combined with specific country codes
+func validateCountryCodesWildcard() validator.List {
+	return countryCodesWildcardValidator{}
+}
+
+type countryCodesWildcardValidator struct{}
+
+func (v countryCodesWildcardValidator) Description(ctx context.Context) string {
+	return "* cannot be combined with other country codes"
+}
+
+func (v countryCodesWildcardValidator) MarkdownDescription(ctx context.Context) string {
+	return "`*` cannot be combined with other country codes"
+}
+
+func (v countryCodesWildcardValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	if len(elements) < 2 {
+		return
+	}
+
+	if lo.Contains(elements, attr.Value(types.StringValue("*"))) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Contradictory Country Codes",
+			"* already matches all countries and cannot be combined with specific country codes.",
+		)
+	}
+}
+
+// validateUniqueCountryCodes ensures each country code is only listed once,
+// ignoring the case as countryCodeValidator does
+func validateUniqueCountryCodes() validator.List {
+	return uniqueCountryCodesValidator{}
+}
+
+type uniqueCountryCodesValidator struct{}
+
+func (v uniqueCountryCodesValidator) Description(ctx context.Context) string {
+	return "each country code must only be listed once, regardless of its case"
+}
+
+func (v uniqueCountryCodesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueCountryCodesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, element := range req.ConfigValue.Elements() {
+		countryCode, ok := element.(types.String)
+		if !ok || countryCode.IsNull() || countryCode.IsUnknown() {
+			continue
+		}
+
+		key := strings.ToUpper(countryCode.ValueString())
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Duplicate Country Code",
+				fmt.Sprintf("%q is already listed.", countryCode.ValueString()),
+			)
+			continue
+		}
+		seen[key] = true
+	}
+}
+
+// validateUniqueDestinations ensures each site_name and city_name is only listed once
+func validateUniqueDestinations() validator.List {
+	return uniqueDestinationsValidator{}
+}
+
+type uniqueDestinationsValidator struct{}
+
+func (v uniqueDestinationsValidator) Description(ctx context.Context) string {
+	return "each site_name and city_name must only be listed once"
+}
+
+func (v uniqueDestinationsValidator) MarkdownDescription(ctx context.Context) string {
+	return "each `site_name` and `city_name` must only be listed once"
+}
+
+func (v uniqueDestinationsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[[2]string]bool{}
+	for i, element := range req.ConfigValue.Elements() {
+		destination, ok := element.(types.Object)
+		if !ok || destination.IsNull() || destination.IsUnknown() {
+			continue
+		}
+
+		siteName, _ := destination.Attributes()["site_name"].(types.String)
+		cityName, _ := destination.Attributes()["city_name"].(types.String)
+		if siteName.IsNull() || siteName.IsUnknown() || cityName.IsNull() || cityName.IsUnknown() {
+			continue
+		}
+
+		key := [2]string{siteName.ValueString(), cityName.ValueString()}
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Duplicate Distribution Destination",
+				fmt.Sprintf("site_name %q and city_name %q are already listed. Merge the country codes of both destinations.", key[0], key[1]),
+			)
+			continue
+		}
+		seen[key] = true
+	}
+}
+
 // planModifierForEmptyMap ensures empty maps are set when not provided
 type planModifierForEmptyMap struct{}
 
@@ -179,7 +556,7 @@ func (m planModifierForEmptyMap) PlanModifyMap(ctx context.Context, req planmodi
 	// If the value is null or unknown, set it to an empty map
 	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
 		resp.PlanValue = types.MapValueMust(
-			types.ListType{ElemType: types.StringType},
+			permissionActionsListType,
 			map[string]attr.Value{},
 		)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ var Version = "1.0.0"
 var productId = "terraform-provider-distribution/" + Version
 
 var _ provider.Provider = &DistributionProvider{}
+var _ provider.ProviderWithEphemeralResources = &DistributionProvider{}
 
 type DistributionProvider struct {
 	Meta util.ProviderMetadata
@@ -136,6 +138,7 @@ func (p *DistributionProvider) Configure(ctx context.Context, req provider.Confi
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
 }
 
 func (p *DistributionProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -145,16 +148,32 @@ func (p *DistributionProvider) Metadata(ctx context.Context, req provider.Metada
 
 func (p *DistributionProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// NewDataSource,
+		NewReleaseBundleV1DataSource,
+		NewReleaseBundleV1VersionsDataSource,
+		NewReleaseBundleV1DistributionStatusDataSource,
+		NewEdgeNodesDataSource,
+		NewSigningKeyDataSource,
+		NewSigningKeysDataSource,
 	}
 }
 
 func (p *DistributionProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewReleaseBundleV1Resource,
+		NewReleaseBundleV1DistributionResource,
+		NewReleaseBundleV1SignatureResource,
 		NewSigningKeyResource,
+		NewDefaultSigningKeyResource,
+		NewSigningKeyPropagationResource,
 		NewVaultSigningKeyResource,
 		NewPermissionResource,
+		NewPermissionTargetPrincipalResource,
+	}
+}
+
+func (p *DistributionProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSigningKeySecretsEphemeralResource,
 	}
 }
 
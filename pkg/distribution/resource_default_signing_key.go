@@ -0,0 +1,207 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const SigningKeyDefaultEndpoint = "distribution/api/v1/keys/{protocol}/{alias}/set_default"
+
+func NewDefaultSigningKeyResource() resource.Resource {
+	return &DefaultSigningKeyResource{
+		TypeName: "distribution_default_signing_key",
+	}
+}
+
+type DefaultSigningKeyResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type DefaultSigningKeyResourceModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	Alias    types.String `tfsdk:"alias"`
+}
+
+func (r *DefaultSigningKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *DefaultSigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gpg", "pgp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Type of the signing key. Valid value: `gpg` or `pgp`",
+			},
+			"alias": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Alias of the signing key to use as default signing key",
+			},
+		},
+		MarkdownDescription: "This resource enables you to manage the default signing key of a protocol. A change of the default signing key outside of Terraform is detected and reverted. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).\n\n" +
+			"~>Distribution always keeps a default signing key once one is set. Destroying this resource only removes it from the Terraform state.\n\n" +
+			"->Do not set `set_as_default` on `distribution_signing_key` or `distribution_vault_signing_key` for the same protocol when using this resource.",
+	}
+}
+
+func (r *DefaultSigningKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *DefaultSigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan DefaultSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": plan.Protocol.ValueString(),
+			"alias":    plan.Alias.ValueString(),
+		}).
+		Put(SigningKeyDefaultEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DefaultSigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state DefaultSigningKeyResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var signingKeys []SigningKeyGetAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("protocol", state.Protocol.ValueString()).
+		SetResult(&signingKeys).
+		Get(SigningKeysEndpoint)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	defaultKey, found := lo.Find(
+		signingKeys,
+		func(signingKey SigningKeyGetAPIModel) bool {
+			return signingKey.IsDefault
+		},
+	)
+
+	// No default signing key, e.g. it was deleted, so it needs to be set again.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Alias = types.StringValue(defaultKey.Alias)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DefaultSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan DefaultSigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": plan.Protocol.ValueString(),
+			"alias":    plan.Alias.ValueString(),
+		}).
+		Put(SigningKeyDefaultEndpoint)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, response.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DefaultSigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state DefaultSigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Distribution has no API to unset the default signing key, so the resource
+	// is only removed from the Terraform state.
+	resp.Diagnostics.AddWarning(
+		"Default Signing Key Not Unset",
+		fmt.Sprintf("Distribution does not support unsetting the default signing key. %s remains the default %s signing key.", state.Alias.ValueString(), state.Protocol.ValueString()),
+	)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *DefaultSigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("protocol"), req, resp)
+}
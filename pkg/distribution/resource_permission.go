@@ -2,8 +2,10 @@ package distribution
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +20,9 @@ import (
 	"github.com/samber/lo"
 )
 
+var _ resource.ResourceWithValidateConfig = &PermissionResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionResource{}
+
 func NewPermissionResource() resource.Resource {
 	return &PermissionResource{
 		TypeName: "distribution_permission_target",
@@ -48,6 +53,140 @@ func (r *PermissionResource) Configure(ctx context.Context, req resource.Configu
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
+func (r *PermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PermissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ResourceType.IsUnknown() || config.ReleaseBundle.IsUnknown() {
+		return
+	}
+
+	switch config.ResourceType.ValueString() {
+	case permissionResourceTypeReleaseBundle:
+		if config.ReleaseBundle.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("release_bundle"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("release_bundle must be set when resource_type is %s.", permissionResourceTypeReleaseBundle),
+			)
+		}
+	case permissionResourceTypeDestination:
+		if !config.ReleaseBundle.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("release_bundle"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("release_bundle can only be set when resource_type is %s.", permissionResourceTypeReleaseBundle),
+			)
+		}
+	}
+}
+
+// matchesDestination returns whether the value matches the site name, city
+// name or a country code of a distribution destination, which is either "*" or
+// a value compared ignoring case.
+func matchesDestination(pattern, value string) bool {
+	return pattern == "*" || strings.EqualFold(pattern, value)
+}
+
+func (r *PermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DistributionDestinations.IsNull() || plan.DistributionDestinations.IsUnknown() {
+		return
+	}
+
+	// Destinations are only checked when they are created or changed, so
+	// plans of unchanged permissions do not read the Edge Nodes.
+	if !req.State.Raw.IsNull() {
+		var state PermissionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.DistributionDestinations.Equal(state.DistributionDestinations) {
+			return
+		}
+	}
+
+	var destinations []types.Object
+	resp.Diagnostics.Append(plan.DistributionDestinations.ElementsAs(ctx, &destinations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var edges []JPDAPIModel
+	edgesRead := false
+	for i, destination := range destinations {
+		siteName, _ := destination.Attributes()["site_name"].(types.String)
+		cityName, _ := destination.Attributes()["city_name"].(types.String)
+		countryCodesValue, _ := destination.Attributes()["country_codes"].(types.List)
+		if siteName.IsUnknown() || cityName.IsUnknown() || countryCodesValue.IsUnknown() {
+			continue
+		}
+
+		var countryCodeValues []types.String
+		resp.Diagnostics.Append(countryCodesValue.ElementsAs(ctx, &countryCodeValues, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if lo.SomeBy(countryCodeValues, func(countryCode types.String) bool { return countryCode.IsUnknown() }) {
+			continue
+		}
+
+		countryCodes := lo.Map(countryCodeValues, func(countryCode types.String, _ int) string {
+			return countryCode.ValueString()
+		})
+
+		allCountries := lo.Contains(countryCodes, "*")
+		if siteName.ValueString() == "*" && cityName.ValueString() == "*" && allCountries {
+			continue
+		}
+
+		if !edgesRead {
+			var err error
+			edges, err = listEdgeNodes(r.ProviderData)
+			// The Edge Node inventory is optional, so destinations are not
+			// checked if it cannot be read.
+			if err != nil {
+				tflog.Warn(ctx, "Unable to read Edge Nodes, distribution destinations are not checked", map[string]interface{}{
+					"error": err.Error(),
+				})
+				return
+			}
+			edgesRead = true
+		}
+
+		matched := lo.SomeBy(edges, func(edge JPDAPIModel) bool {
+			return matchesDestination(siteName.ValueString(), edge.Name) &&
+				matchesDestination(cityName.ValueString(), edge.Location.CityName) &&
+				(allCountries || lo.SomeBy(countryCodes, func(countryCode string) bool {
+					return matchesDestination(countryCode, edge.Location.CountryCode)
+				}))
+		})
+		if !matched {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("distribution_destinations").AtListIndex(i),
+				"No Matching Edge Node",
+				fmt.Sprintf("No registered Edge Node matches site_name %q, city_name %q and country_codes %s. The permission does not apply to any Edge Node until a matching one is registered.", siteName.ValueString(), cityName.ValueString(), strings.Join(countryCodes, ", ")),
+			)
+		}
+	}
+}
+
 func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -79,7 +218,7 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, putErr.String())
+		utilfw.UnableToCreateResourceError(resp, putErr.errorMessage(response.String()))
 		return
 	}
 
@@ -103,10 +242,12 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var permission PermissionAPIModel
+	var getErr PermissionErrorAPIModel
 
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("permissionName", state.Name.ValueString()).
 		SetResult(&permission).
+		SetError(&getErr).
 		Get(PermissionEndpoint)
 	if err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -119,7 +260,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, response.String())
+		utilfw.UnableToRefreshResourceError(resp, getErr.errorMessage(response.String()))
 		return
 	}
 
@@ -150,9 +291,12 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	var putErr PermissionErrorAPIModel
+
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("permissionName", plan.Name.ValueString()).
 		SetBody(apiModel).
+		SetError(&putErr).
 		Put(PermissionEndpoint)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -160,7 +304,7 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, response.String())
+		utilfw.UnableToUpdateResourceError(resp, putErr.errorMessage(response.String()))
 		return
 	}
 
@@ -176,8 +320,11 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	var deleteErr PermissionErrorAPIModel
+
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("permissionName", state.Name.ValueString()).
+		SetError(&deleteErr).
 		Delete(PermissionEndpoint)
 
 	if err != nil {
@@ -185,8 +332,13 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// The permission target was already deleted outside of Terraform.
+	if response.StatusCode() == http.StatusNotFound {
+		return
+	}
+
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, response.String())
+		utilfw.UnableToDeleteResourceError(resp, deleteErr.errorMessage(response.String()))
 		return
 	}
 
@@ -212,29 +364,30 @@ func (r *PermissionResource) toAPIModel(ctx context.Context, model PermissionRes
 		apiModel.DistributionDestinations = destinations
 	}
 
+	// Handle release_bundle
+	if !model.ReleaseBundle.IsNull() && !model.ReleaseBundle.IsUnknown() {
+		var releaseBundle PermissionReleaseBundle
+		diags.Append(model.ReleaseBundle.As(ctx, &releaseBundle, basetypes.ObjectAsOptions{})...)
+		apiModel.ReleaseBundle = &releaseBundle
+	}
+
 	// Handle principals
 	if !model.Principals.IsNull() && !model.Principals.IsUnknown() {
 		var principals PermissionPrincipals
 		diags.Append(model.Principals.As(ctx, &principals, basetypes.ObjectAsOptions{})...)
 
-		// Sort permissions for consistency
+		// Convert long-form actions to their codes and sort them for consistency
 		sortedPrincipals := PermissionPrincipals{
 			Users:  make(map[string][]string),
 			Groups: make(map[string][]string),
 		}
 
 		for user, perms := range principals.Users {
-			sortedPerms := make([]string, len(perms))
-			copy(sortedPerms, perms)
-			sort.Strings(sortedPerms)
-			sortedPrincipals.Users[user] = sortedPerms
+			sortedPrincipals.Users[user] = permissionActionCodes(perms)
 		}
 
 		for group, perms := range principals.Groups {
-			sortedPerms := make([]string, len(perms))
-			copy(sortedPerms, perms)
-			sort.Strings(sortedPerms)
-			sortedPrincipals.Groups[group] = sortedPerms
+			sortedPrincipals.Groups[group] = permissionActionCodes(perms)
 		}
 
 		apiModel.Principals = sortedPrincipals
@@ -287,6 +440,21 @@ func (r *PermissionResource) fromAPIModel(ctx context.Context, apiModel Permissi
 		})
 	}
 
+	// Handle release_bundle - an empty exclude_patterns is the same as not
+	// setting it
+	if apiModel.ReleaseBundle != nil {
+		releaseBundle := *apiModel.ReleaseBundle
+		if len(releaseBundle.ExcludePatterns) == 0 {
+			releaseBundle.ExcludePatterns = nil
+		}
+
+		releaseBundleValue, diagVal := types.ObjectValueFrom(ctx, permissionReleaseBundleAttrTypes, releaseBundle)
+		diags.Append(diagVal...)
+		model.ReleaseBundle = releaseBundleValue
+	} else {
+		model.ReleaseBundle = types.ObjectNull(permissionReleaseBundleAttrTypes)
+	}
+
 	// Handle principals - always create the object structure with empty maps
 	principals := PermissionPrincipals{
 		Users:  make(map[string][]string),
@@ -313,10 +481,7 @@ func (r *PermissionResource) fromAPIModel(ctx context.Context, apiModel Permissi
 		}
 	}
 
-	principalsValue, diagVal := types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"users":  types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
-		"groups": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
-	}, principals)
+	principalsValue, diagVal := types.ObjectValueFrom(ctx, permissionPrincipalsAttrTypes, principals)
 	diags.Append(diagVal...)
 	model.Principals = principalsValue
 
@@ -0,0 +1,441 @@
+package distribution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+const (
+	permissionPrincipalTypeUser  = "user"
+	permissionPrincipalTypeGroup = "group"
+)
+
+// permissionTargetLocks serializes the read-modify-write of each permission
+// target, so principals of the same target added in parallel do not overwrite
+// each other.
+var permissionTargetLocks sync.Map
+
+func lockPermissionTarget(name string) func() {
+	lock, _ := permissionTargetLocks.LoadOrStore(name, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func NewPermissionTargetPrincipalResource() resource.Resource {
+	return &PermissionTargetPrincipalResource{
+		TypeName: "distribution_permission_target_principal",
+	}
+}
+
+type PermissionTargetPrincipalResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type PermissionTargetPrincipalResourceModel struct {
+	PermissionTargetName types.String `tfsdk:"permission_target_name"`
+	Type                 types.String `tfsdk:"type"`
+	Name                 types.String `tfsdk:"name"`
+	Actions              types.List   `tfsdk:"actions"`
+}
+
+// principals returns the users or groups of the permission target, depending
+// on the type of the principal.
+func (m PermissionTargetPrincipalResourceModel) principals(apiModel *PermissionAPIModel) map[string][]string {
+	if m.Type.ValueString() == permissionPrincipalTypeGroup {
+		if apiModel.Principals.Groups == nil {
+			apiModel.Principals.Groups = map[string][]string{}
+		}
+		return apiModel.Principals.Groups
+	}
+
+	if apiModel.Principals.Users == nil {
+		apiModel.Principals.Users = map[string][]string{}
+	}
+	return apiModel.Principals.Users
+}
+
+func (r *PermissionTargetPrincipalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *PermissionTargetPrincipalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"permission_target_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the existing permission target",
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionPrincipalTypeUser, permissionPrincipalTypeGroup),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Type of the principal. Valid value: `user` or `group`",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the user or group",
+			},
+			"actions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(validPermissionActions()...),
+					),
+				},
+				MarkdownDescription: "Actions granted to the principal. Valid actions: `r` or `read`, `n` or `annotate`, `x` or `distribute`, `d` or `delete`, and `m` or `manage`.",
+			},
+		},
+		MarkdownDescription: "This resource enables you to grant permissions of an existing permission target to a single user or group, without managing the other principals of the permission target. For more information, see [Permission Management](https://jfrog.com/help/r/jfrog-platform-administration-documentation/permission-management) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/permission-management).\n\n" +
+			"~>If the permission target is managed with `distribution_permission_target`, add `principals` to its `lifecycle.ignore_changes`. Otherwise both resources overwrite the principals of each other. A change of the principals made elsewhere while they are updated fails the apply, but is only detected after the update, so principals granted elsewhere which the update overwrote must be granted again before retrying the apply.",
+	}
+}
+
+func (r *PermissionTargetPrincipalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// getPermissionTarget returns the permission target, and whether it exists.
+func (r *PermissionTargetPrincipalResource) getPermissionTarget(name string) (PermissionAPIModel, bool, error) {
+	permission, _, found, err := r.readPermissionTarget(name)
+	return permission, found, err
+}
+
+// readPermissionTarget returns the permission target, all the fields returned
+// by the API, including the ones not in PermissionAPIModel, and whether it
+// exists.
+func (r *PermissionTargetPrincipalResource) readPermissionTarget(name string) (PermissionAPIModel, map[string]json.RawMessage, bool, error) {
+	var permission PermissionAPIModel
+	var getErr PermissionErrorAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("permissionName", name).
+		SetResult(&permission).
+		SetError(&getErr).
+		Get(PermissionEndpoint)
+	if err != nil {
+		return permission, nil, false, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return permission, nil, false, nil
+	}
+
+	if response.IsError() {
+		return permission, nil, false, fmt.Errorf("%s", getErr.errorMessage(response.String()))
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(response.Body(), &fields); err != nil {
+		return permission, nil, false, err
+	}
+
+	return permission, fields, true, nil
+}
+
+// updatePrincipal sets the actions of the principal on permission, read with
+// all its fields by readPermissionTarget, or removes the principal if actions
+// is nil. Only the principals are replaced, so fields of the permission target
+// which the provider does not model are written back unchanged.
+//
+// The API has no conditional update, so a change of the principals made
+// elsewhere after permission was read is overwritten. The permission target is
+// read again after the update, which fails if any principal of the update is
+// missing or changed. This only detects the conflict: principals granted
+// elsewhere which the update overwrote are not restored, and must be granted
+// again.
+func (r *PermissionTargetPrincipalResource) updatePrincipal(model PermissionTargetPrincipalResourceModel, actions []string, permission PermissionAPIModel, fields map[string]json.RawMessage) error {
+	expected := permission
+	expected.Principals = PermissionPrincipals{
+		Users:  maps.Clone(permission.Principals.Users),
+		Groups: maps.Clone(permission.Principals.Groups),
+	}
+	if actions == nil {
+		delete(model.principals(&expected), model.Name.ValueString())
+	} else {
+		model.principals(&expected)[model.Name.ValueString()] = actions
+	}
+
+	principals, err := json.Marshal(expected.Principals)
+	if err != nil {
+		return err
+	}
+	fields["principals"] = principals
+
+	var putErr PermissionErrorAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("permissionName", permission.Name).
+		SetBody(fields).
+		SetError(&putErr).
+		Put(PermissionEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", putErr.errorMessage(response.String()))
+	}
+
+	updated, found, err := r.getPermissionTarget(permission.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("permission target %s was deleted while it was updated", permission.Name)
+	}
+
+	// Principals added concurrently after the update are kept, but any
+	// principal of the update missing or changed means it was overwritten.
+	changed := changedPrincipals(expected.Principals, updated.Principals)
+	if _, granted := model.principals(&updated)[model.Name.ValueString()]; actions == nil && granted {
+		changed = append(changed, fmt.Sprintf("%s %s", model.Type.ValueString(), model.Name.ValueString()))
+	}
+	if len(changed) > 0 {
+		return fmt.Errorf("permission target %s was changed concurrently while it was updated (%s), retry the apply", permission.Name, strings.Join(changed, ", "))
+	}
+
+	return nil
+}
+
+// planActions returns the API codes of the planned actions.
+func (r *PermissionTargetPrincipalResource) planActions(ctx context.Context, plan PermissionTargetPrincipalResourceModel) ([]string, diag.Diagnostics) {
+	var actions []string
+	diags := plan.Actions.ElementsAs(ctx, &actions, false)
+	return permissionActionCodes(actions), diags
+}
+
+func (r *PermissionTargetPrincipalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan PermissionTargetPrincipalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions, diags := r.planActions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockPermissionTarget(plan.PermissionTargetName.ValueString())
+	defer unlock()
+
+	permission, fields, found, err := r.readPermissionTarget(plan.PermissionTargetName.ValueString())
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if !found {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("permission target %s not found", plan.PermissionTargetName.ValueString()))
+		return
+	}
+
+	if _, granted := plan.principals(&permission)[plan.Name.ValueString()]; granted {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("%s %s is already granted permissions on permission target %s. Import it to manage it.", plan.Type.ValueString(), plan.Name.ValueString(), plan.PermissionTargetName.ValueString()))
+		return
+	}
+
+	if err := r.updatePrincipal(plan, actions, permission, fields); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PermissionTargetPrincipalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state PermissionTargetPrincipalResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, found, err := r.getPermissionTarget(state.PermissionTargetName.ValueString())
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	actions, granted := state.principals(&permission)[state.Name.ValueString()]
+	if !granted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var stateActions []string
+	if !state.Actions.IsNull() {
+		resp.Diagnostics.Append(state.Actions.ElementsAs(ctx, &stateActions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Actions are returned as sorted codes, so the actions in the state are
+	// kept when they grant the same permissions.
+	if !sameActions(stateActions, actions) {
+		actionsValue, diags := types.ListValueFrom(ctx, types.StringType, permissionActionCodes(actions))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Actions = actionsValue
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PermissionTargetPrincipalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan PermissionTargetPrincipalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions, diags := r.planActions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockPermissionTarget(plan.PermissionTargetName.ValueString())
+	defer unlock()
+
+	permission, fields, found, err := r.readPermissionTarget(plan.PermissionTargetName.ValueString())
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	if !found {
+		utilfw.UnableToUpdateResourceError(resp, fmt.Sprintf("permission target %s not found", plan.PermissionTargetName.ValueString()))
+		return
+	}
+
+	if err := r.updatePrincipal(plan, actions, permission, fields); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PermissionTargetPrincipalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state PermissionTargetPrincipalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockPermissionTarget(state.PermissionTargetName.ValueString())
+	defer unlock()
+
+	permission, fields, found, err := r.readPermissionTarget(state.PermissionTargetName.ValueString())
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// Nothing to remove if the permission target or the principal is already
+	// gone.
+	if !found {
+		return
+	}
+
+	if _, granted := state.principals(&permission)[state.Name.ValueString()]; !granted {
+		return
+	}
+
+	if err := r.updatePrincipal(state, nil, permission, fields); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *PermissionTargetPrincipalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" ||
+		(parts[1] != permissionPrincipalTypeUser && parts[1] != permissionPrincipalTypeGroup) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected permission_target_name:user:name or permission_target_name:group:name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_target_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
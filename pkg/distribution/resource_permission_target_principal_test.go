@@ -0,0 +1,149 @@
+package distribution_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccPermissionTargetPrincipal_full(t *testing.T) {
+	_, _, permissionName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+	_, fqrn, resourceName := testutil.MkNames("test-permission-target-principal", "distribution_permission_target_principal")
+	userName := generateRandomName("test-user")
+	groupName := generateRandomName("test-group")
+
+	const template = `
+	resource "artifactory_managed_user" "test-user" {
+		name     = "{{ .userName }}"
+		password = "Password1!"
+		email    = "test@tempurl.org"
+	}
+
+	resource "platform_group" "testgroup" {
+		name                       = "{{ .groupName }}"
+		description 	           = "Test group"
+		auto_join                  = true
+		admin_privileges           = false
+		use_group_members_resource = false
+	}
+
+	resource "distribution_permission_target" "{{ .permissionName }}" {
+		name        = "{{ .permissionName }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"{{ .userName }}" = ["d", "x"]
+			}
+		}
+		depends_on = [
+			artifactory_managed_user.test-user
+		]
+
+		lifecycle {
+			ignore_changes = [principals]
+		}
+	}
+
+	resource "distribution_permission_target_principal" "{{ .name }}" {
+		permission_target_name = distribution_permission_target.{{ .permissionName }}.name
+		type                   = "group"
+		name                   = platform_group.testgroup.name
+		actions                = {{ .actions }}
+	}`
+
+	testData := map[string]string{
+		"name":           resourceName,
+		"permissionName": permissionName,
+		"userName":       userName,
+		"groupName":      groupName,
+		"actions":        `["distribute"]`,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTargetPrincipal_full", template, testData)
+
+	testData["actions"] = `["x", "delete"]`
+	updatedConfig := util.ExecuteTemplate("TestAccPermissionTargetPrincipal_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"platform": {
+				Source: "jfrog/platform",
+			},
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "permission_target_name", permissionName),
+					resource.TestCheckResourceAttr(fqrn, "type", "group"),
+					resource.TestCheckResourceAttr(fqrn, "name", groupName),
+					resource.TestCheckResourceAttr(fqrn, "actions.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "actions.0", "distribute"),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s:group:%s", permissionName, groupName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"actions"},
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "actions.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "actions.0", "x"),
+					resource.TestCheckResourceAttr(fqrn, "actions.1", "delete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTargetPrincipal_InvalidAction(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target-principal", "distribution_permission_target_principal")
+
+	const template = `
+	resource "distribution_permission_target_principal" "{{ .name }}" {
+		permission_target_name = "test-permission-target"
+		type                   = "user"
+		name                   = "test-user"
+		actions                = ["x", "distribut"]
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTargetPrincipal_InvalidAction", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`value must be one of:(.|\n)*got: "distribut"`),
+			},
+		},
+	})
+}
@@ -3,11 +3,16 @@ package distribution_test
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -104,6 +109,94 @@ func TestAccPermissionTarget_basic(t *testing.T) {
 	})
 }
 
+// deletePermissionTarget deletes the permission target outside of Terraform.
+func deletePermissionTarget(t *testing.T, name string) {
+	url := fmt.Sprintf("%s/distribution/api/v1/security/permissions/%s", strings.TrimSuffix(os.Getenv("JFROG_URL"), "/"), name)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("JFROG_ACCESS_TOKEN"))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		t.Fatalf("failed to delete permission target %s: %s", name, resp.Status)
+	}
+}
+
+func TestAccPermissionTarget_DeletedOutsideTerraform(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+	userName := generateRandomName("test-user")
+
+	const template = `
+	resource "artifactory_managed_user" "test-user" {
+		name     = "{{ .userName }}"
+		password = "Password1!"
+		email    = "test@tempurl.org"
+	}
+
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"{{ .userName }}" = ["d", "x"]
+			}
+		}
+		depends_on = [
+			artifactory_managed_user.test-user
+		]
+	}`
+
+	testData := map[string]string{
+		"name":     resourceName,
+		"userName": userName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_DeletedOutsideTerraform", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+				),
+			},
+			{
+				PreConfig: func() {
+					deletePermissionTarget(t, resourceName)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+				),
+			},
+		},
+	})
+}
+
 // Test cases for validation rules
 
 func TestAccPermissionTarget_InvalidResourceType(t *testing.T) {
@@ -136,7 +229,7 @@ func TestAccPermissionTarget_InvalidResourceType(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      config,
-				ExpectError: regexp.MustCompile(`Attribute resource_type value must be one of: \["destination"\], got:`),
+				ExpectError: regexp.MustCompile(`Attribute resource_type value must be one of: \["destination"\s+"release_bundle"\], got:`),
 			},
 		},
 	})
@@ -460,3 +553,419 @@ func TestAccPermissionTarget_ValidWithOnlyGroups(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPermissionTarget_ReleaseBundle(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+	userName := generateRandomName("test-user")
+
+	const template = `
+	resource "artifactory_managed_user" "test-user" {
+		name     = "{{ .userName }}"
+		password = "Password1!"
+		email    = "test@tempurl.org"
+	}
+
+	resource "distribution_permission_target" "{{ .name }}" {
+		name          = "{{ .name }}"
+		resource_type = "release_bundle"
+		release_bundle = {
+			include_patterns = ["app-*"]
+			exclude_patterns = ["app-internal-*"]
+		}
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["DE", "FR"]
+		}]
+		principals = {
+			users = {
+				"{{ .userName }}" = ["r", "x"]
+			}
+		}
+		depends_on = [
+			artifactory_managed_user.test-user
+		]
+	}`
+
+	testData := map[string]string{
+		"name":     resourceName,
+		"userName": userName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_ReleaseBundle", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "resource_type", "release_bundle"),
+					resource.TestCheckResourceAttr(fqrn, "release_bundle.include_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "release_bundle.include_patterns.0", "app-*"),
+					resource.TestCheckResourceAttr(fqrn, "release_bundle.exclude_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "release_bundle.exclude_patterns.0", "app-internal-*"),
+					resource.TestCheckResourceAttr(fqrn, "distribution_destinations.0.country_codes.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "principals.users."+userName+".0", "r"),
+					resource.TestCheckResourceAttr(fqrn, "principals.users."+userName+".1", "x"),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        testData["name"],
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_MissingReleaseBundle(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "release_bundle"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_MissingReleaseBundle", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`release_bundle must be set when resource_type is\s+release_bundle`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_ReleaseBundleWithDestinationType(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		release_bundle = {
+			include_patterns = ["app-*"]
+		}
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_ReleaseBundleWithDestinationType", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`release_bundle can only be set when resource_type is\s+release_bundle`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_LongFormActions(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+	userName := generateRandomName("test-user")
+
+	const template = `
+	resource "artifactory_managed_user" "test-user" {
+		name     = "{{ .userName }}"
+		password = "Password1!"
+		email    = "test@tempurl.org"
+	}
+
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"{{ .userName }}" = ["distribute", "delete"]
+			}
+		}
+		depends_on = [
+			artifactory_managed_user.test-user
+		]
+	}`
+
+	testData := map[string]string{
+		"name":     resourceName,
+		"userName": userName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_LongFormActions", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "principals.users."+userName+".0", "distribute"),
+					resource.TestCheckResourceAttr(fqrn, "principals.users."+userName+".1", "delete"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:  fqrn,
+				ImportState:   true,
+				ImportStateId: testData["name"],
+				// Imported actions are the sorted codes returned by the API.
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+
+					for i, code := range []string{"d", "x"} {
+						key := fmt.Sprintf("principals.users.%s.%d", userName, i)
+						if actual := states[0].Attributes[key]; actual != code {
+							return fmt.Errorf("expected %s to be %s, got %s", key, code, actual)
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_InvalidAction(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "distribut"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_InvalidAction", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`value must be one of:(.|\n)*got: "distribut"`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_InvalidCountryCode(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["US", "XX"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_InvalidCountryCode", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`"XX" is not an ISO 3166-1 alpha-2 country code`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_WildcardWithCountryCodes(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*", "US"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_WildcardWithCountryCodes", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Contradictory Country Codes`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_DuplicateCountryCodes(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["US", "us"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_DuplicateCountryCodes", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Duplicate Country Code`),
+			},
+		},
+	})
+}
+
+func TestAccPermissionTarget_DuplicateDestinations(t *testing.T) {
+	_, _, resourceName := testutil.MkNames("test-permission-target", "distribution_permission_target")
+
+	const template = `
+	resource "distribution_permission_target" "{{ .name }}" {
+		name        = "{{ .name }}"
+		resource_type = "destination"
+		distribution_destinations = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["US"]
+		},
+		{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["DE"]
+		}]
+		principals = {
+			users = {
+				"test-user" = ["x", "d"]
+			}
+		}
+	}`
+
+	testData := map[string]string{
+		"name": resourceName,
+	}
+
+	config := util.ExecuteTemplate("TestAccPermissionTarget_DuplicateDestinations", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Duplicate Distribution Destination`),
+			},
+		},
+	})
+}
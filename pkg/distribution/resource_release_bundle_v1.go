@@ -2,9 +2,13 @@ package distribution
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/samber/lo"
@@ -28,6 +35,20 @@ const (
 	ReleaseBundleV1Endpoint  = "distribution/api/v1/release_bundle/{name}/{version}"
 )
 
+const (
+	defaultReleaseBundleV1CreateTimeout = 20 * time.Minute
+	defaultReleaseBundleV1UpdateTimeout = 20 * time.Minute
+)
+
+// releaseBundleV1States lists the states of a release bundle version in the
+// order they are reached during its lifecycle.
+var releaseBundleV1States = []string{"OPEN", "SIGNED", "STORED", "READY_FOR_DISTRIBUTION"}
+
+const (
+	releaseBundleV1StateReached = "REACHED"
+	releaseBundleV1StatePending = "PENDING"
+)
+
 func NewReleaseBundleV1Resource() resource.Resource {
 	return &ReleaseBundleV1Resource{
 		TypeName: "distribution_release_bundle_v1",
@@ -39,12 +60,11 @@ type ReleaseBundleV1Resource struct {
 	TypeName     string
 }
 
-type ReleaseBundleV1ResourceModel struct {
+// ReleaseBundleV1CommonModel holds the attributes shared by the release bundle
+// resource and data source.
+type ReleaseBundleV1CommonModel struct {
 	Name              types.String `tfsdk:"name"`
 	Version           types.String `tfsdk:"version"`
-	GPGPassphase      types.String `tfsdk:"gpg_passphase"`
-	DryRun            types.Bool   `tfsdk:"dry_run"`
-	SignImmediately   types.Bool   `tfsdk:"sign_immediately"`
 	StoringRepository types.String `tfsdk:"storing_repository"`
 	Description       types.String `tfsdk:"description"`
 	ReleaseNotes      types.Object `tfsdk:"release_notes"`
@@ -58,6 +78,45 @@ type ReleaseBundleV1ResourceModel struct {
 	Archived          types.Bool   `tfsdk:"archived"`
 }
 
+type ReleaseBundleV1ResourceModel struct {
+	ReleaseBundleV1CommonModel
+	GPGPassphase    types.String   `tfsdk:"gpg_passphase"`
+	GPGPassphraseWO types.String   `tfsdk:"gpg_passphrase_wo"`
+	DryRun          types.Bool     `tfsdk:"dry_run"`
+	SignImmediately types.Bool     `tfsdk:"sign_immediately"`
+	WaitForState    types.String   `tfsdk:"wait_for_state"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// gpgPassphrase returns the passphrase for the signing key, preferring the
+// write-only gpg_passphrase_wo attribute, which is only available in the
+// configuration.
+func gpgPassphrase(ctx context.Context, config tfsdk.Config, passphrase types.String) (types.String, diag.Diagnostics) {
+	var passphraseWO types.String
+	diags := config.GetAttribute(ctx, path.Root("gpg_passphrase_wo"), &passphraseWO)
+	if !passphraseWO.IsNull() {
+		return passphraseWO, diags
+	}
+
+	return passphrase, diags
+}
+
+// onlyWaitChanged returns whether the model differs from the state in
+// wait_for_state and timeouts only, which do not change the release bundle
+// version in Distribution. Computed attributes are unknown in the plan of an
+// update and are not compared.
+func (m ReleaseBundleV1ResourceModel) onlyWaitChanged(state ReleaseBundleV1ResourceModel) bool {
+	return m.Name.Equal(state.Name) &&
+		m.Version.Equal(state.Version) &&
+		(m.StoringRepository.IsUnknown() || m.StoringRepository.Equal(state.StoringRepository)) &&
+		m.Description.Equal(state.Description) &&
+		m.ReleaseNotes.Equal(state.ReleaseNotes) &&
+		m.Spec.Equal(state.Spec) &&
+		m.GPGPassphase.Equal(state.GPGPassphase) &&
+		m.DryRun.Equal(state.DryRun) &&
+		m.SignImmediately.Equal(state.SignImmediately)
+}
+
 func (m ReleaseBundleV1ResourceModel) toAPIModel(ctx context.Context, apiModel *ReleaseBundleV1APIModel) (diags diag.Diagnostics) {
 	apiModel.Name = m.Name.ValueString()
 	apiModel.Version = m.Version.ValueString()
@@ -280,7 +339,7 @@ var specAttrType = map[string]attr.Type{
 	},
 }
 
-func (m *ReleaseBundleV1ResourceModel) fromGetAPIModel(ctx context.Context, apiModel ReleaseBundleV1GetAPIModel) (diags diag.Diagnostics) {
+func (m *ReleaseBundleV1CommonModel) fromGetAPIModel(ctx context.Context, apiModel ReleaseBundleV1GetAPIModel) (diags diag.Diagnostics) {
 	m.Name = types.StringValue(apiModel.Name)
 	m.Version = types.StringValue(apiModel.Version)
 	m.StoringRepository = types.StringValue(apiModel.StoringRepository)
@@ -528,7 +587,17 @@ func (r *ReleaseBundleV1Resource) Schema(ctx context.Context, req resource.Schem
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
-				Description: "Passphrase for the signing key, if applicable",
+				MarkdownDescription: "Passphrase for the signing key, if applicable. Conflicts with `gpg_passphrase_wo`.",
+			},
+			"gpg_passphrase_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("gpg_passphase")),
+				},
+				MarkdownDescription: "Write-only passphrase for the signing key, if applicable, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Conflicts with `gpg_passphase`.",
 			},
 			"dry_run": schema.BoolAttribute{
 				Optional:            true,
@@ -655,6 +724,17 @@ func (r *ReleaseBundleV1Resource) Schema(ctx context.Context, req resource.Schem
 				Required:    true,
 				Description: "Describes the specification by artifacts are gathered and distributed in this release bundle.",
 			},
+			"wait_for_state": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(releaseBundleV1States[1:]...),
+				},
+				MarkdownDescription: "When set, create and update wait until the release bundle version reaches this state (or a later one) before returning. Useful with `sign_immediately` as signing of large release bundles is asynchronous. Valid values: `SIGNED`, `STORED`, `READY_FOR_DISTRIBUTION`. Wait time is controlled by `timeouts`.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 			"state": schema.StringAttribute{
 				Computed: true,
 			},
@@ -717,6 +797,70 @@ func (r *ReleaseBundleV1Resource) Configure(ctx context.Context, req resource.Co
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
+// waitForState polls the release bundle version until it reaches targetState, or
+// any state following it in the lifecycle, or until the timeout is reached. The
+// last state read is returned alongside any error, and is empty if it is not
+// known.
+func (r *ReleaseBundleV1Resource) waitForState(ctx context.Context, name, version, targetState string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{releaseBundleV1StatePending},
+		Target:  []string{releaseBundleV1StateReached},
+		Refresh: func() (interface{}, string, error) {
+			var releaseBundle ReleaseBundleV1GetAPIModel
+
+			response, err := r.ProviderData.Client.R().
+				SetPathParams(map[string]string{
+					"name":    name,
+					"version": version,
+				}).
+				SetQueryParam("format", "json").
+				SetResult(&releaseBundle).
+				Get(ReleaseBundleV1Endpoint)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if response.IsError() {
+				return nil, "", fmt.Errorf("%s", response.String())
+			}
+
+			tflog.Debug(ctx, "Release Bundle V1 state", map[string]interface{}{
+				"state":        releaseBundle.State,
+				"target_state": targetState,
+			})
+
+			if strings.Contains(releaseBundle.State, "FAILED") {
+				return releaseBundle, "", fmt.Errorf("release bundle version entered state %s", releaseBundle.State)
+			}
+
+			if lo.IndexOf(releaseBundleV1States, releaseBundle.State) >= lo.IndexOf(releaseBundleV1States, targetState) {
+				return releaseBundle, releaseBundleV1StateReached, nil
+			}
+
+			return releaseBundle, releaseBundleV1StatePending, nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	// The release bundle version is only returned once the target state is
+	// reached or a failed state is entered, not on timeout.
+	result, err := stateConf.WaitForStateContext(ctx)
+	if releaseBundle, ok := result.(ReleaseBundleV1GetAPIModel); ok {
+		return releaseBundle.State, err
+	}
+
+	return "", err
+}
+
+func unableToReachStateError(diags *diag.Diagnostics, targetState string, err error) {
+	diags.AddError(
+		"Release Bundle State Not Reached",
+		fmt.Sprintf("The release bundle version did not reach state %s. Increase the 'timeouts' if signing or storing is still in progress, otherwise check the Distribution logs.\n\nError: %s", targetState, err),
+	)
+}
+
 func (r *ReleaseBundleV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -734,12 +878,24 @@ func (r *ReleaseBundleV1Resource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultReleaseBundleV1CreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var result ReleaseBundleV1PostResponseAPIModel
 
+	passphrase, diags := gpgPassphrase(ctx, req.Config, plan.GPGPassphase)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := r.ProviderData.Client.R()
 
-	if !plan.GPGPassphase.IsNull() {
-		request.SetHeader("X-GPG-PASSPHRASE", plan.GPGPassphase.ValueString())
+	if !passphrase.IsNull() {
+		request.SetHeader("X-GPG-PASSPHRASE", passphrase.ValueString())
 	}
 
 	response, err := request.
@@ -762,6 +918,20 @@ func (r *ReleaseBundleV1Resource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	if !plan.WaitForState.IsNull() && !plan.DryRun.ValueBool() {
+		state, err := r.waitForState(ctx, plan.Name.ValueString(), plan.Version.ValueString(), plan.WaitForState.ValueString(), createTimeout)
+		if state != "" {
+			plan.State = types.StringValue(state)
+		}
+		if err != nil {
+			// The release bundle version exists at this point so it is saved into state,
+			// which Terraform will mark as tainted due to the error.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			unableToReachStateError(&resp.Diagnostics, plan.WaitForState.ValueString(), err)
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -823,18 +993,48 @@ func (r *ReleaseBundleV1Resource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	var state ReleaseBundleV1ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing is sent to Distribution when only how to wait for the release
+	// bundle version changed.
+	if plan.onlyWaitChanged(state) {
+		state.WaitForState = plan.WaitForState
+		state.Timeouts = plan.Timeouts
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	var releaseBundle ReleaseBundleV1APIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &releaseBundle)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultReleaseBundleV1UpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var result ReleaseBundleV1PostResponseAPIModel
 
+	passphrase, diags := gpgPassphrase(ctx, req.Config, plan.GPGPassphase)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := r.ProviderData.Client.R()
 
-	if !plan.GPGPassphase.IsNull() {
-		request.SetHeader("X-GPG-PASSPHRASE", plan.GPGPassphase.ValueString())
+	if !passphrase.IsNull() {
+		request.SetHeader("X-GPG-PASSPHRASE", passphrase.ValueString())
 	}
 
 	response, err := request.
@@ -861,6 +1061,18 @@ func (r *ReleaseBundleV1Resource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	if !plan.WaitForState.IsNull() && !plan.DryRun.ValueBool() {
+		lastState, err := r.waitForState(ctx, plan.Name.ValueString(), plan.Version.ValueString(), plan.WaitForState.ValueString(), updateTimeout)
+		if lastState != "" {
+			plan.State = types.StringValue(lastState)
+		}
+		if err != nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			unableToReachStateError(&resp.Diagnostics, plan.WaitForState.ValueString(), err)
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -0,0 +1,581 @@
+package distribution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const (
+	ReleaseBundleV1DistributeEndpoint          = "distribution/api/v1/distribution/{name}/{version}"
+	ReleaseBundleV1DeleteDistributionEndpoint  = "distribution/api/v1/distribution/{name}/{version}/delete"
+	ReleaseBundleV1DistributionsEndpoint       = "distribution/api/v1/release_bundle/{name}/{version}/distribution"
+	ReleaseBundleV1DistributionTrackerEndpoint = "distribution/api/v1/release_bundle/{name}/{version}/distribution/{trackerId}"
+)
+
+const (
+	distributionStatusCompleted = "Completed"
+	distributionStatusFailed    = "Failed"
+	distributionStatusPending   = "Pending"
+)
+
+const (
+	defaultDistributionCreateTimeout = 30 * time.Minute
+	defaultDistributionDeleteTimeout = 30 * time.Minute
+)
+
+func NewReleaseBundleV1DistributionResource() resource.Resource {
+	return &ReleaseBundleV1DistributionResource{
+		TypeName: "distribution_release_bundle_v1_distribution",
+	}
+}
+
+type ReleaseBundleV1DistributionResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV1DistributionResourceModel struct {
+	Name                          types.String   `tfsdk:"name"`
+	Version                       types.String   `tfsdk:"version"`
+	DistributionRules             types.List     `tfsdk:"distribution_rules"`
+	AutoCreateMissingRepositories types.Bool     `tfsdk:"auto_create_missing_repositories"`
+	TrackerID                     types.String   `tfsdk:"tracker_id"`
+	Sites                         types.List     `tfsdk:"sites"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m ReleaseBundleV1DistributionResourceModel) toAPIModel(ctx context.Context, apiModel *ReleaseBundleV1DistributeRequestAPIModel) (diags diag.Diagnostics) {
+	var rules []DistributionDestination
+	diags.Append(m.DistributionRules.ElementsAs(ctx, &rules, false)...)
+
+	apiModel.DryRun = false
+	apiModel.AutoCreateMissingRepositories = m.AutoCreateMissingRepositories.ValueBool()
+	apiModel.DistributionRules = rules
+
+	return
+}
+
+var distributionSiteAttrType = map[string]attr.Type{
+	"name":       types.StringType,
+	"service_id": types.StringType,
+	"status":     types.StringType,
+	"error":      types.StringType,
+}
+
+var distributionSiteObjectType = types.ObjectType{
+	AttrTypes: distributionSiteAttrType,
+}
+
+func (m *ReleaseBundleV1DistributionResourceModel) fromTrackerAPIModel(_ context.Context, apiModel ReleaseBundleV1DistributionTrackerAPIModel) (diags diag.Diagnostics) {
+	m.TrackerID = types.StringValue(apiModel.ID.String())
+
+	sites := lo.Map(
+		apiModel.Sites,
+		func(site ReleaseBundleV1DistributionSiteAPIModel, _ int) attr.Value {
+			s, d := types.ObjectValue(
+				distributionSiteAttrType,
+				map[string]attr.Value{
+					"name":       types.StringValue(site.TargetArtifactory.Name),
+					"service_id": types.StringValue(site.TargetArtifactory.ServiceID),
+					"status":     types.StringValue(site.Status),
+					"error":      types.StringValue(site.Error),
+				},
+			)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			return s
+		},
+	)
+
+	sitesList, d := types.ListValue(distributionSiteObjectType, sites)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+	m.Sites = sitesList
+
+	return
+}
+
+type ReleaseBundleV1DistributeRequestAPIModel struct {
+	DryRun                        bool                      `json:"dry_run"`
+	AutoCreateMissingRepositories bool                      `json:"auto_create_missing_repositories"`
+	DistributionRules             []DistributionDestination `json:"distribution_rules"`
+}
+
+type ReleaseBundleV1DeleteDistributionRequestAPIModel struct {
+	DryRun            bool                      `json:"dry_run"`
+	DistributionRules []DistributionDestination `json:"distribution_rules"`
+	OnSuccess         string                    `json:"on_success"`
+}
+
+type ReleaseBundleV1DistributeResponseAPIModel struct {
+	ID    json.Number                               `json:"id"`
+	Sites []ReleaseBundleV1DistributionSiteAPIModel `json:"sites"`
+}
+
+type ReleaseBundleV1DistributionTrackerAPIModel struct {
+	ID                   json.Number                               `json:"distribution_tracker_id"`
+	ReleaseBundleName    string                                    `json:"release_bundle_name"`
+	ReleaseBundleVersion string                                    `json:"release_bundle_version"`
+	Type                 string                                    `json:"type"`
+	Status               string                                    `json:"status"`
+	StartTime            string                                    `json:"start_time"`
+	FinishTime           string                                    `json:"finish_time"`
+	Sites                []ReleaseBundleV1DistributionSiteAPIModel `json:"sites"`
+}
+
+type ReleaseBundleV1DistributionSiteAPIModel struct {
+	Status            string                                    `json:"status"`
+	Error             string                                    `json:"general_error"`
+	TargetArtifactory ReleaseBundleV1DistributionTargetAPIModel `json:"target_artifactory"`
+	TotalFiles        int64                                     `json:"total_files"`
+	TotalBytes        int64                                     `json:"total_bytes"`
+	DistributedFiles  int64                                     `json:"distributed_files"`
+	DistributedBytes  int64                                     `json:"distributed_bytes"`
+	FileErrors        []string                                  `json:"file_errors"`
+}
+
+type ReleaseBundleV1DistributionTargetAPIModel struct {
+	ServiceID string `json:"service_id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+}
+
+type ReleaseBundleV1DistributionErrorAPIModel struct {
+	StatusCode int    `json:"status_code"`
+	Message    string `json:"message"`
+	Detail     string `json:"detail"`
+}
+
+func (m ReleaseBundleV1DistributionErrorAPIModel) String() string {
+	return fmt.Sprintf("%d - %s: %s", m.StatusCode, m.Message, m.Detail)
+}
+
+// distributionTrackerStatus folds the per-site statuses of a tracker into one of
+// Completed, Failed or Pending. A tracker is only complete once every site is.
+func distributionTrackerStatus(tracker ReleaseBundleV1DistributionTrackerAPIModel) string {
+	if len(tracker.Sites) == 0 {
+		switch tracker.Status {
+		case distributionStatusCompleted, distributionStatusFailed:
+			return tracker.Status
+		default:
+			return distributionStatusPending
+		}
+	}
+
+	if lo.SomeBy(tracker.Sites, func(site ReleaseBundleV1DistributionSiteAPIModel) bool {
+		return site.Status == distributionStatusFailed
+	}) {
+		return distributionStatusFailed
+	}
+
+	if lo.EveryBy(tracker.Sites, func(site ReleaseBundleV1DistributionSiteAPIModel) bool {
+		return site.Status == distributionStatusCompleted
+	}) {
+		return distributionStatusCompleted
+	}
+
+	return distributionStatusPending
+}
+
+func (m ReleaseBundleV1DistributionTrackerAPIModel) failedSites() string {
+	failed := lo.FilterMap(
+		m.Sites,
+		func(site ReleaseBundleV1DistributionSiteAPIModel, _ int) (string, bool) {
+			if site.Status != distributionStatusFailed {
+				return "", false
+			}
+			return fmt.Sprintf("%s (%s): %s", site.TargetArtifactory.Name, site.TargetArtifactory.ServiceID, site.Error), true
+		},
+	)
+	return strings.Join(failed, ",\n")
+}
+
+func (r *ReleaseBundleV1DistributionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ReleaseBundleV1DistributionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					nameVersionRegexValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the release bundle to distribute.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					nameVersionRegexValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Version of the release bundle to distribute.",
+			},
+			"distribution_rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"site_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the Edge Node site. Wildcard `*` matches all sites.",
+						},
+						"city_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the Edge Node city. Wildcard `*` matches all cities.",
+						},
+						"country_codes": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "Country codes of the Edge Nodes. Wildcard `*` matches all countries.",
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Description: "Rules selecting the Edge Nodes to distribute the release bundle to.",
+			},
+			"auto_create_missing_repositories": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "When set to `true`, repositories missing on the Edge Nodes are created automatically.",
+			},
+			"tracker_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the distribution tracker.",
+			},
+			"sites": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Edge Node.",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service ID of the Edge Node.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Distribution status on the Edge Node.",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error reported by the Edge Node, if any.",
+						},
+					},
+				},
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Distribution status of each target Edge Node.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+		MarkdownDescription: "This resource enables you to distribute a Release Bundle V1 version to Edge Nodes. The resource waits until every target Edge Node reports the distribution as completed. On destroy, the release bundle is removed from the same Edge Nodes. For more information, see [Distributing Release Bundles](https://jfrog.com/help/r/jfrog-distribution-documentation/distributing-release-bundles) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/distribute-release-bundle-v1-version).\n\n" +
+			"~>User must have matching Distribution permissions for the target Edge Nodes.",
+	}
+}
+
+func (r *ReleaseBundleV1DistributionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// waitForDistribution polls the distribution tracker until every target site
+// reports a terminal status, or until the timeout is reached.
+func (r *ReleaseBundleV1DistributionResource) waitForDistribution(ctx context.Context, name, version, trackerID string, timeout time.Duration) (ReleaseBundleV1DistributionTrackerAPIModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{distributionStatusPending},
+		Target:  []string{distributionStatusCompleted},
+		Refresh: func() (interface{}, string, error) {
+			var tracker ReleaseBundleV1DistributionTrackerAPIModel
+			var getErr ReleaseBundleV1DistributionErrorAPIModel
+
+			response, err := r.ProviderData.Client.R().
+				SetPathParams(map[string]string{
+					"name":      name,
+					"version":   version,
+					"trackerId": trackerID,
+				}).
+				SetResult(&tracker).
+				SetError(&getErr).
+				Get(ReleaseBundleV1DistributionTrackerEndpoint)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if response.IsError() {
+				return nil, "", fmt.Errorf("%s", getErr.String())
+			}
+
+			status := distributionTrackerStatus(tracker)
+			tflog.Debug(ctx, "Release Bundle V1 distribution status", map[string]interface{}{
+				"tracker_id": trackerID,
+				"status":     status,
+			})
+
+			if status == distributionStatusFailed {
+				return tracker, status, fmt.Errorf("distribution failed on Edge Node(s):\n%s", tracker.failedSites())
+			}
+
+			return tracker, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if result == nil {
+		return ReleaseBundleV1DistributionTrackerAPIModel{}, err
+	}
+
+	return result.(ReleaseBundleV1DistributionTrackerAPIModel), err
+}
+
+func (r *ReleaseBundleV1DistributionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReleaseBundleV1DistributionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDistributionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var distribution ReleaseBundleV1DistributeRequestAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &distribution)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result ReleaseBundleV1DistributeResponseAPIModel
+	var postErr ReleaseBundleV1DistributionErrorAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":    plan.Name.ValueString(),
+			"version": plan.Version.ValueString(),
+		}).
+		SetBody(distribution).
+		SetResult(&result).
+		SetError(&postErr).
+		Post(ReleaseBundleV1DistributeEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, postErr.String())
+		return
+	}
+
+	tracker, err := r.waitForDistribution(ctx, plan.Name.ValueString(), plan.Version.ValueString(), result.ID.String(), createTimeout)
+	if err != nil {
+		// The distribution is still tracked by Distribution at this point, so
+		// the tracker ID is saved into state, which Terraform will mark as
+		// tainted due to the error.
+		plan.TrackerID = types.StringValue(result.ID.String())
+		plan.Sites = types.ListNull(distributionSiteObjectType)
+		if tracker.ID.String() != "" {
+			resp.Diagnostics.Append(plan.fromTrackerAPIModel(ctx, tracker)...)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromTrackerAPIModel(ctx, tracker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReleaseBundleV1DistributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV1DistributionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tracker ReleaseBundleV1DistributionTrackerAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":      state.Name.ValueString(),
+			"version":   state.Version.ValueString(),
+			"trackerId": state.TrackerID.ValueString(),
+		}).
+		SetResult(&tracker).
+		Get(ReleaseBundleV1DistributionTrackerEndpoint)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromTrackerAPIModel(ctx, tracker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReleaseBundleV1DistributionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReleaseBundleV1DistributionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute sent to Distribution requires replacement, so only
+	// 'timeouts' can change here and there is nothing to send to the API.
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReleaseBundleV1DistributionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV1DistributionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDistributionDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []DistributionDestination
+	resp.Diagnostics.Append(state.DistributionRules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result ReleaseBundleV1DistributeResponseAPIModel
+	var postErr ReleaseBundleV1DistributionErrorAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":    state.Name.ValueString(),
+			"version": state.Version.ValueString(),
+		}).
+		SetBody(ReleaseBundleV1DeleteDistributionRequestAPIModel{
+			DryRun:            false,
+			DistributionRules: rules,
+			OnSuccess:         "keep",
+		}).
+		SetResult(&result).
+		SetError(&postErr).
+		Post(ReleaseBundleV1DeleteDistributionEndpoint)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, postErr.String())
+		return
+	}
+
+	if _, err := r.waitForDistribution(ctx, state.Name.ValueString(), state.Version.ValueString(), result.ID.String(), deleteTimeout); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
@@ -0,0 +1,67 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// TestAccReleaseBundleV1Distribution_full would only be successful when executed against Artifactory instance
+// that has Distribution enabled (i.e. has edge node(s) configured) and a default signing key
+func TestAccReleaseBundleV1Distribution_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v1-distribution", "distribution_release_bundle_v1_distribution")
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "{{ .version }}"
+		sign_immediately = true
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	resource "distribution_release_bundle_v1_distribution" "{{ .name }}" {
+		name = distribution_release_bundle_v1.{{ .name }}.name
+		version = distribution_release_bundle_v1.{{ .name }}.version
+
+		distribution_rules = [{
+			site_name     = "*"
+			city_name     = "*"
+			country_codes = ["*"]
+		}]
+
+		auto_create_missing_repositories = true
+	}`
+
+	testData := map[string]string{
+		"name":    resourceName,
+		"version": "1.0.0",
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1Distribution_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "version", testData["version"]),
+					resource.TestCheckResourceAttr(fqrn, "distribution_rules.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "distribution_rules.0.site_name", "*"),
+					resource.TestCheckResourceAttr(fqrn, "auto_create_missing_repositories", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "tracker_id"),
+					resource.TestCheckResourceAttrSet(fqrn, "sites.#"),
+					resource.TestCheckResourceAttr(fqrn, "sites.0.status", "Completed"),
+				),
+			},
+		},
+	})
+}
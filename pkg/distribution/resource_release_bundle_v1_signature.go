@@ -0,0 +1,321 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+const ReleaseBundleV1SignEndpoint = "distribution/api/v1/release_bundle/{name}/{version}/sign"
+
+func NewReleaseBundleV1SignatureResource() resource.Resource {
+	return &ReleaseBundleV1SignatureResource{
+		TypeName: "distribution_release_bundle_v1_signature",
+	}
+}
+
+type ReleaseBundleV1SignatureResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReleaseBundleV1SignatureResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	Version           types.String `tfsdk:"version"`
+	SigningKeyAlias   types.String `tfsdk:"signing_key_alias"`
+	GPGPassphrase     types.String `tfsdk:"gpg_passphrase"`
+	GPGPassphraseWO   types.String `tfsdk:"gpg_passphrase_wo"`
+	StoringRepository types.String `tfsdk:"storing_repository"`
+	State             types.String `tfsdk:"state"`
+}
+
+func (m ReleaseBundleV1SignatureResourceModel) toAPIModel(_ context.Context, apiModel *ReleaseBundleV1SignRequestAPIModel) {
+	apiModel.SigningKeyAlias = m.SigningKeyAlias.ValueString()
+	apiModel.StoringRepository = m.StoringRepository.ValueString()
+}
+
+func (m *ReleaseBundleV1SignatureResourceModel) fromAPIModel(_ context.Context, apiModel ReleaseBundleV1GetAPIModel) {
+	m.StoringRepository = types.StringValue(apiModel.StoringRepository)
+	m.State = types.StringValue(apiModel.State)
+}
+
+type ReleaseBundleV1SignRequestAPIModel struct {
+	SigningKeyAlias   string `json:"signing_key_alias,omitempty"`
+	StoringRepository string `json:"storing_repository,omitempty"`
+}
+
+func (r *ReleaseBundleV1SignatureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ReleaseBundleV1SignatureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					nameVersionRegexValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the release bundle to sign.",
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					nameVersionRegexValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Version of the release bundle to sign.",
+			},
+			"signing_key_alias": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Alias of the signing key to sign with. If not provided, the default signing key is used.",
+			},
+			"gpg_passphrase": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Passphrase for the signing key, if applicable. Conflicts with `gpg_passphrase_wo`.",
+			},
+			"gpg_passphrase_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("gpg_passphrase")),
+				},
+				MarkdownDescription: "Write-only passphrase for the signing key, if applicable, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Conflicts with `gpg_passphrase`.",
+			},
+			"storing_repository": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "A repository name at source Artifactory to store release bundle artifacts in. If not provided, Artifactory will use the default one.",
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "State of the release bundle version after signing.",
+			},
+		},
+		MarkdownDescription: "This resource enables you to sign an existing Release Bundle V1 version that is in `OPEN` state. Use it to create release bundles in one workspace and sign them in another one that holds the signing key passphrase. For more information, see [REST API](https://jfrog.com/help/r/jfrog-rest-apis/sign-release-bundle-v1-version).\n\n" +
+			"~>A signature cannot be removed. Destroying this resource only removes it from the Terraform state.",
+	}
+}
+
+func (r *ReleaseBundleV1SignatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ReleaseBundleV1SignatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReleaseBundleV1SignatureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var releaseBundle ReleaseBundleV1GetAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":    plan.Name.ValueString(),
+			"version": plan.Version.ValueString(),
+		}).
+		SetQueryParam("format", "json").
+		SetResult(&releaseBundle).
+		Get(ReleaseBundleV1Endpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	if releaseBundle.State != "OPEN" {
+		resp.Diagnostics.AddError(
+			"Release Bundle Not Open",
+			fmt.Sprintf("Release bundle %s version %s is in state %s. Only release bundle versions in OPEN state can be signed.", plan.Name.ValueString(), plan.Version.ValueString(), releaseBundle.State),
+		)
+		return
+	}
+
+	var signRequest ReleaseBundleV1SignRequestAPIModel
+	plan.toAPIModel(ctx, &signRequest)
+
+	var result ReleaseBundleV1GetAPIModel
+
+	passphrase, diags := gpgPassphrase(ctx, req.Config, plan.GPGPassphrase)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.ProviderData.Client.R()
+
+	if !passphrase.IsNull() {
+		request.SetHeader("X-GPG-PASSPHRASE", passphrase.ValueString())
+	}
+
+	response, err = request.
+		SetPathParams(map[string]string{
+			"name":    plan.Name.ValueString(),
+			"version": plan.Version.ValueString(),
+		}).
+		SetBody(signRequest).
+		SetResult(&result).
+		Post(ReleaseBundleV1SignEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	plan.fromAPIModel(ctx, result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReleaseBundleV1SignatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ReleaseBundleV1SignatureResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var releaseBundle ReleaseBundleV1GetAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"name":    state.Name.ValueString(),
+			"version": state.Version.ValueString(),
+		}).
+		SetQueryParam("format", "json").
+		SetResult(&releaseBundle).
+		Get(ReleaseBundleV1Endpoint)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// An OPEN release bundle version is no longer signed, e.g. it was recreated
+	// outside of Terraform, so it needs to be signed again.
+	if releaseBundle.State == "OPEN" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.fromAPIModel(ctx, releaseBundle)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReleaseBundleV1SignatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReleaseBundleV1SignatureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only 'gpg_passphrase' can change without replacement, and it is only used
+	// when signing, so there is nothing to send to the API.
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReleaseBundleV1SignatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	// Distribution has no API to remove a signature from a release bundle
+	// version, so the resource is only removed from the Terraform state.
+	resp.Diagnostics.AddWarning(
+		"Release Bundle Signature Not Removed",
+		"Distribution does not support removing a signature. The release bundle version remains signed.",
+	)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ReleaseBundleV1SignatureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected name:version",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), parts[1])...)
+}
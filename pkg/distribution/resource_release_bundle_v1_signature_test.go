@@ -0,0 +1,62 @@
+package distribution_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// TestAccReleaseBundleV1Signature_full requires a default signing key to be configured in Distribution
+func TestAccReleaseBundleV1Signature_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v1-signature", "distribution_release_bundle_v1_signature")
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "{{ .version }}"
+		sign_immediately = false
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+	}
+
+	resource "distribution_release_bundle_v1_signature" "{{ .name }}" {
+		name = distribution_release_bundle_v1.{{ .name }}.name
+		version = distribution_release_bundle_v1.{{ .name }}.version
+	}`
+
+	testData := map[string]string{
+		"name":    resourceName,
+		"version": "1.0.0",
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1Signature_full", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "version", testData["version"]),
+					resource.TestCheckResourceAttrSet(fqrn, "storing_repository"),
+					resource.TestCheckResourceAttrSet(fqrn, "state"),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s:%s", testData["name"], testData["version"]),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -187,6 +188,75 @@ func TestAccReleaseBundleV1_full(t *testing.T) {
 	})
 }
 
+// TestAccReleaseBundleV1_wait_for_state requires a default signing key to be configured in Distribution
+func TestAccReleaseBundleV1_wait_for_state(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("test-release-bundle-v1", "distribution_release_bundle_v1")
+
+	const template = `
+	resource "distribution_release_bundle_v1" "{{ .name }}" {
+		name = "{{ .name }}"
+		version = "{{ .version }}"
+		sign_immediately = true
+		wait_for_state = "SIGNED"
+
+		spec = {
+			queries = [{
+				aql = "items.find({ \"repo\" : \"example-repo-local\" })"
+			}]
+		}
+
+		timeouts = {
+			create = "{{ .timeout }}"
+		}
+	}`
+
+	testData := map[string]string{
+		"name":    resourceName,
+		"version": "1.0.0",
+		"timeout": "5m",
+	}
+
+	config := util.ExecuteTemplate("TestAccReleaseBundleV1_wait_for_state", template, testData)
+
+	updatedTestData := map[string]string{
+		"name":    resourceName,
+		"version": "1.0.0",
+		"timeout": "10m",
+	}
+
+	updatedConfig := util.ExecuteTemplate("TestAccReleaseBundleV1_wait_for_state", template, updatedTestData)
+
+	// Signing may complete and the release bundle version move on to storing
+	// before its state is read.
+	reachedState := regexp.MustCompile(`^(SIGNED|STORED|READY_FOR_DISTRIBUTION)$`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "wait_for_state", "SIGNED"),
+					resource.TestCheckResourceAttr(fqrn, "timeouts.create", "5m"),
+					resource.TestMatchResourceAttr(fqrn, "state", reachedState),
+				),
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "timeouts.create", "10m"),
+					resource.TestMatchResourceAttr(fqrn, "state", reachedState),
+				),
+			},
+		},
+	})
+}
+
 func TestAccReleaseBundleV1_invalid_name(t *testing.T) {
 	testCases := []struct {
 		name       string
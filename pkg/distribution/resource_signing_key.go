@@ -1,17 +1,29 @@
 package distribution
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
@@ -31,9 +43,37 @@ type SigningKeyResource struct {
 
 type SigningKeyResourceModel struct {
 	SigningKeyCommmonResourceModel
-	PublicKey  types.String `tfsdk:"public_key"`
-	PrivateKey types.String `tfsdk:"private_key"`
-	Passphrase types.String `tfsdk:"passphrase"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	PrivateKeyWO   types.String `tfsdk:"private_key_wo"`
+	Passphrase     types.String `tfsdk:"passphrase"`
+	PassphraseWO   types.String `tfsdk:"passphrase_wo"`
+	KeyWOVersion   types.Int64  `tfsdk:"key_wo_version"`
+	RotateOnChange types.Bool   `tfsdk:"rotate_on_change"`
+	KeyGeneration  types.Object `tfsdk:"key_generation"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	KeyID          types.String `tfsdk:"key_id"`
+	Algorithm      types.String `tfsdk:"algorithm"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+type SigningKeyGenerationResourceModel struct {
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Name       types.String `tfsdk:"name"`
+	Email      types.String `tfsdk:"email"`
+	Comment    types.String `tfsdk:"comment"`
+	ExpiryDays types.Int64  `tfsdk:"expiry_days"`
+}
+
+func (m SigningKeyResourceModel) keyChanged(other SigningKeyResourceModel) bool {
+	return !m.PublicKey.Equal(other.PublicKey) ||
+		!m.KeyGeneration.Equal(other.KeyGeneration) ||
+		!m.PrivateKey.Equal(other.PrivateKey) ||
+		!m.Passphrase.Equal(other.Passphrase) ||
+		!m.KeyWOVersion.Equal(other.KeyWOVersion) ||
+		!m.PropagateToEdgeNode.Equal(other.PropagateToEdgeNode) ||
+		!m.FailOnPropagationFailure.Equal(other.FailOnPropagationFailure) ||
+		!m.SetAsDefault.Equal(other.SetAsDefault)
 }
 
 func (m SigningKeyResourceModel) toAPIModel(_ context.Context, apiModel *SigningKeyPostRequestAPIModel) (diags diag.Diagnostics) {
@@ -50,6 +90,239 @@ func (m SigningKeyResourceModel) toAPIModel(_ context.Context, apiModel *Signing
 	return
 }
 
+// withWriteOnly returns a copy of the model with the write-only private key and
+// passphrase of the configuration. Write-only values are only available in the
+// configuration, never in the plan or state.
+func (m SigningKeyResourceModel) withWriteOnly(config SigningKeyResourceModel) SigningKeyResourceModel {
+	if !config.PrivateKeyWO.IsNull() {
+		m.PrivateKey = config.PrivateKeyWO
+	}
+
+	if !config.PassphraseWO.IsNull() {
+		m.Passphrase = config.PassphraseWO
+	}
+
+	return m
+}
+
+// keyMaterial returns the model to upload to Distribution, with the write-only
+// values of the configuration and the key pair generated for key_generation.
+// The generated public key and its details are set on the model itself, so
+// they are stored in the Terraform state.
+func (m *SigningKeyResourceModel) keyMaterial(ctx context.Context, config tfsdk.Config) (SigningKeyResourceModel, diag.Diagnostics) {
+	var configModel SigningKeyResourceModel
+	diags := config.Get(ctx, &configModel)
+	if diags.HasError() {
+		return *m, diags
+	}
+
+	upload := m.withWriteOnly(configModel)
+
+	if !m.KeyGeneration.IsNull() {
+		privateKey, d := m.generateKeyPair(ctx, upload.Passphrase.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return upload, diags
+		}
+
+		upload.PublicKey = m.PublicKey
+		upload.PrivateKey = types.StringValue(privateKey)
+	}
+
+	return upload, diags
+}
+
+// generateKeyPair generates the key pair configured in key_generation and sets
+// the public key and its details. The private key is only returned for the
+// upload and never stored in the Terraform state.
+func (m *SigningKeyResourceModel) generateKeyPair(ctx context.Context, passphrase string) (string, diag.Diagnostics) {
+	var keyGeneration SigningKeyGenerationResourceModel
+	diags := m.KeyGeneration.As(ctx, &keyGeneration, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return "", diags
+	}
+
+	publicKey, privateKey, err := generateArmoredKeyPair(
+		keyGeneration.Algorithm.ValueString(),
+		keyGeneration.Name.ValueString(),
+		keyGeneration.Comment.ValueString(),
+		keyGeneration.Email.ValueString(),
+		time.Duration(keyGeneration.ExpiryDays.ValueInt64())*24*time.Hour,
+		passphrase,
+	)
+	if err != nil {
+		diags.AddError(
+			"Unable to Generate Signing Key",
+			err.Error(),
+		)
+		return "", diags
+	}
+
+	m.PublicKey = types.StringValue(publicKey)
+	diags.Append(m.setPublicKeyDetails()...)
+
+	return privateKey, diags
+}
+
+func (m *SigningKeyResourceModel) setPublicKeyDetails() (diags diag.Diagnostics) {
+	details, err := readPublicKeyDetails(m.PublicKey.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("public_key"),
+			"Invalid Public Key",
+			fmt.Sprintf("Failed to parse public key: %s", err),
+		)
+		return
+	}
+
+	m.Fingerprint = types.StringValue(details.Fingerprint)
+	m.KeyID = types.StringValue(details.KeyID)
+	m.Algorithm = types.StringValue(details.Algorithm)
+	m.ExpiresAt = expiresAtValue(details)
+
+	return
+}
+
+// setPublicKeyDetailsUnknown marks the public key details as known after apply.
+func (m *SigningKeyResourceModel) setPublicKeyDetailsUnknown() {
+	m.Fingerprint = types.StringUnknown()
+	m.KeyID = types.StringUnknown()
+	m.Algorithm = types.StringUnknown()
+	m.ExpiresAt = types.StringUnknown()
+}
+
+// validateKeyPair checks that the public and private key form a key pair and
+// that the passphrase decrypts the private key.
+func (m SigningKeyResourceModel) validateKeyPair() (diags diag.Diagnostics) {
+	publicKey, err := readArmoredKey(m.PublicKey.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("public_key"),
+			"Invalid Public Key",
+			fmt.Sprintf("Failed to parse public key: %s", err),
+		)
+	}
+
+	privateKey, err := readArmoredKey(m.PrivateKey.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("private_key"),
+			"Invalid Private Key",
+			fmt.Sprintf("Failed to parse private key: %s", err),
+		)
+	} else if privateKey.PrivateKey == nil {
+		diags.AddAttributeError(
+			path.Root("private_key"),
+			"Invalid Private Key",
+			"The private key does not contain private key material.",
+		)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	if !bytes.Equal(publicKey.PrimaryKey.Fingerprint, privateKey.PrimaryKey.Fingerprint) {
+		diags.AddAttributeError(
+			path.Root("private_key"),
+			"Signing Key Mismatch",
+			fmt.Sprintf("The private key %X does not belong to the public key %X.", privateKey.PrimaryKey.Fingerprint, publicKey.PrimaryKey.Fingerprint),
+		)
+		return
+	}
+
+	if !privateKey.PrivateKey.Encrypted {
+		return
+	}
+
+	if m.Passphrase.IsNull() {
+		diags.AddAttributeError(
+			path.Root("passphrase"),
+			"Missing Passphrase",
+			"The private key is encrypted. Set passphrase to decrypt it.",
+		)
+		return
+	}
+
+	if err := privateKey.DecryptPrivateKeys([]byte(m.Passphrase.ValueString())); err != nil {
+		if isPrivateKeyChecksumFailure(err) {
+			diags.AddAttributeWarning(
+				path.Root("passphrase"),
+				"Invalid Passphrase",
+				fmt.Sprintf("The passphrase may not decrypt the private key, Distribution rejects the signing key if it does not: %s", err),
+			)
+			return
+		}
+
+		diags.AddAttributeError(
+			path.Root("passphrase"),
+			"Invalid Passphrase",
+			fmt.Sprintf("The passphrase does not decrypt the private key: %s", err),
+		)
+	}
+
+	return
+}
+
+// rotateOnChange returns whether the signing key is rotated in place, or
+// replaced, when its key material or upload options change.
+func rotateOnChange(ctx context.Context, config tfsdk.Config, private privateStateGetter) (bool, diag.Diagnostics) {
+	var rotate types.Bool
+	diags := config.GetAttribute(ctx, path.Root("rotate_on_change"), &rotate)
+
+	return rotate.ValueBool() || isImportedSigningKey(ctx, private), diags
+}
+
+const requiresReplaceUnlessRotatedDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless `rotate_on_change` is set or the resource was imported and has not been updated since."
+
+func stringRequiresReplaceUnlessRotated() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			rotate, diags := rotateOnChange(ctx, req.Config, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !rotate
+		},
+		requiresReplaceUnlessRotatedDescription,
+		requiresReplaceUnlessRotatedDescription,
+	)
+}
+
+func objectRequiresReplaceUnlessRotated() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			rotate, diags := rotateOnChange(ctx, req.Config, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !rotate
+		},
+		requiresReplaceUnlessRotatedDescription,
+		requiresReplaceUnlessRotatedDescription,
+	)
+}
+
+func int64RequiresReplaceUnlessRotated() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			rotate, diags := rotateOnChange(ctx, req.Config, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !rotate
+		},
+		requiresReplaceUnlessRotatedDescription,
+		requiresReplaceUnlessRotatedDescription,
+	)
+}
+
+func boolRequiresReplaceUnlessRotated() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			rotate, diags := rotateOnChange(ctx, req.Config, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !rotate
+		},
+		requiresReplaceUnlessRotatedDescription,
+		requiresReplaceUnlessRotatedDescription,
+	)
+}
+
 type SigningKeyPostRequestAPIModel struct {
 	SigningKeyCommonPostRequestAPIModel
 	Key SigningKeyKeyPostRequestAPIModel `json:"key"`
@@ -67,45 +340,169 @@ func (r *SigningKeyResource) Metadata(ctx context.Context, req resource.Metadata
 }
 
 func (r *SigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: lo.Assign(
-			commonSchemaAttributes,
-			map[string]schema.Attribute{
-				"public_key": schema.StringAttribute{
-					Required: true,
-					Validators: []validator.String{
-						stringvalidator.LengthAtLeast(1),
-					},
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
-					Description: "Public key",
+	attributes := lo.Assign(
+		commonSchemaAttributes,
+		map[string]schema.Attribute{
+			"public_key": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("key_generation")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringRequiresReplaceUnlessRotated(),
 				},
-				"private_key": schema.StringAttribute{
-					Required:  true,
-					Sensitive: true,
-					Validators: []validator.String{
-						stringvalidator.LengthAtLeast(1),
+				MarkdownDescription: "Public key. Required unless `key_generation` is set, in which case it is the generated public key.",
+			},
+			"private_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("private_key_wo"),
+						path.MatchRoot("key_generation"),
+					),
+					stringvalidator.AlsoRequires(path.MatchRoot("public_key")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringRequiresReplaceUnlessRotated(),
+				},
+				MarkdownDescription: "Private key. Conflicts with `private_key_wo` and `key_generation`.",
+			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("public_key")),
+				},
+				MarkdownDescription: "Write-only private key, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Change `key_wo_version` to upload a new private key. Conflicts with `private_key` and `key_generation`.",
+			},
+			"key_wo_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64RequiresReplaceUnlessRotated(),
+				},
+				MarkdownDescription: "Version of `private_key_wo` and `passphrase_wo`. Changes of write-only attributes are not detected, so change this version to upload the signing key again with their new values.",
+			},
+			"key_generation": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(keyAlgorithmRSA4096, keyAlgorithmEd25519),
+						},
+						MarkdownDescription: fmt.Sprintf("Algorithm of the key pair. Valid value: `%s` or `%s`", keyAlgorithmRSA4096, keyAlgorithmEd25519),
 					},
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+					"name": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "Name of the user ID of the key pair",
 					},
-					Description: "Private key",
-				},
-				"passphrase": schema.StringAttribute{
-					Optional:  true,
-					Sensitive: true,
-					Validators: []validator.String{
-						stringvalidator.LengthAtLeast(1),
+					"email": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "Email of the user ID of the key pair",
+					},
+					"comment": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "Comment of the user ID of the key pair",
 					},
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+					"expiry_days": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 36500),
+						},
+						Description: "Number of days the key pair is valid for after it is generated. If not set, the key pair does not expire.",
 					},
-					Description: "Passphrase for key",
 				},
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectRequiresReplaceUnlessRotated(),
+				},
+				MarkdownDescription: "Generate the key pair in the provider instead of providing `public_key` and `private_key`. The private key is protected with `passphrase` or `passphrase_wo` and only uploaded to Distribution, it is never stored in the Terraform state. A new key pair is generated whenever the signing key is replaced or rotated. Conflicts with `public_key` and `private_key`.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Fingerprint of the public key",
+			},
+			"key_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Long key ID of the public key",
+			},
+			"algorithm": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Algorithm of the public key, e.g. `rsa4096` or `ed25519`",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Expiry date of the public key in RFC 3339 format. Not set if the key does not expire.",
+			},
+			"passphrase": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringRequiresReplaceUnlessRotated(),
+				},
+				MarkdownDescription: "Passphrase for key. Conflicts with `passphrase_wo`.",
+			},
+			"passphrase_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("passphrase")),
+				},
+				MarkdownDescription: "Write-only passphrase for key, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Change `key_wo_version` to upload the signing key with a new passphrase. Conflicts with `passphrase`.",
 			},
-		),
-		MarkdownDescription: "This resource enables you to upload and distribute GPG keys to sign Release Bundle V1. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).",
+			"rotate_on_change": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set to `true`, a change of the key material or upload options rotates the signing key instead of replacing it. The new key is uploaded under a temporary alias, becomes the default key if the current one is and is propagated. The current key is then moved to a `-retired` alias, the new key renamed to `alias` and the current key deleted last, so a signing key is available at all times. A rotation which fails part way is finished by the next apply, and keys it left behind are deleted by it or the next rotation.",
+			},
+		},
+	)
+
+	// The upload options are shared with distribution_vault_signing_key, which
+	// cannot rotate its key.
+	for _, name := range []string{"propagate_to_edge_nodes", "fail_on_propagation_failure", "set_as_default"} {
+		attribute := attributes[name].(schema.BoolAttribute)
+		attribute.PlanModifiers = []planmodifier.Bool{
+			boolRequiresReplaceUnlessRotated(),
+		}
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		MarkdownDescription: "This resource enables you to upload and distribute GPG keys to sign Release Bundle V1. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).\n\n" +
+			"~>The private key and passphrase cannot be read from Distribution. When importing an existing signing key, set them in the configuration to match the uploaded key. They are adopted on the next apply without re-uploading the key.",
 	}
 }
 
@@ -128,8 +525,21 @@ func (r *SigningKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	upload, diags := plan.keyMaterial(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.KeyGeneration.IsNull() {
+		resp.Diagnostics.Append(plan.setPublicKeyDetails()...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var signingKey SigningKeyPostRequestAPIModel
-	resp.Diagnostics.Append(plan.toAPIModel(ctx, &signingKey)...)
+	resp.Diagnostics.Append(upload.toAPIModel(ctx, &signingKey)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -157,6 +567,12 @@ func (r *SigningKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		"report": result.Report.String(),
 	})
 
+	report, diags := propagationReportFromAPIModel(result.Report)
+	resp.Diagnostics.Append(diags...)
+	plan.PropagationReport = report
+
+	addPropagationFailureWarnings(&resp.Diagnostics, result.Report)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -196,10 +612,128 @@ func (r *SigningKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Only the public key can be read back, e.g. after import. The private key
+	// and passphrase are never returned by Distribution.
+	//
+	// A different public key means the key was replaced outside of Terraform
+	// under the same alias. Storing it plans a replacement of the resource.
+	if state.PublicKey.IsNull() || !samePublicKey(state.PublicKey.ValueString(), signingKey.PublicKey) {
+		state.PublicKey = types.StringValue(signingKey.PublicKey)
+	}
+
+	// A public key which cannot be parsed, e.g. because of a newer key format,
+	// must not break refresh. The previous details are kept instead.
+	details, err := readPublicKeyDetails(state.PublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("public_key"),
+			"Unable to Parse Public Key",
+			fmt.Sprintf("Failed to parse the public key returned by Distribution, the previous fingerprint, key_id, algorithm and expires_at are kept: %s", err),
+		)
+	} else {
+		// A generated key has no public key in the configuration to plan its
+		// replacement against, so ModifyPlan forces it instead.
+		if !state.KeyGeneration.IsNull() && !state.Fingerprint.IsNull() && state.Fingerprint.ValueString() != details.Fingerprint {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, signingKeyReplacedKey, []byte("true"))...)
+		}
+
+		state.Fingerprint = types.StringValue(details.Fingerprint)
+		state.KeyID = types.StringValue(details.KeyID)
+		state.Algorithm = types.StringValue(details.Algorithm)
+		state.ExpiresAt = expiresAtValue(details)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *SigningKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SigningKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config = config.withWriteOnly(config)
+
+	if !config.KeyGeneration.IsNull() && config.Passphrase.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_generation"),
+			"Missing Passphrase",
+			"Set passphrase or passphrase_wo to protect the generated private key.",
+		)
+		return
+	}
+
+	// Generated key pairs are not configured, and values from other resources
+	// may only be known during apply.
+	if config.PublicKey.IsNull() || config.PublicKey.IsUnknown() ||
+		config.PrivateKey.IsNull() || config.PrivateKey.IsUnknown() ||
+		config.Passphrase.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validateKeyPair()...)
+}
+
+func (r *SigningKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SigningKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	created := req.State.Raw.IsNull()
+
+	var state SigningKeyResourceModel
+	if !created {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replaced := false
+	if !created {
+		value, diags := req.Private.GetKey(ctx, signingKeyReplacedKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		replaced = string(value) == "true"
+	}
+
+	switch {
+	// A generated key replaced outside of Terraform is generated again.
+	case replaced && !plan.KeyGeneration.IsNull():
+		plan.PublicKey = types.StringUnknown()
+		plan.setPublicKeyDetailsUnknown()
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("fingerprint"))
+	// A rotation generates a new key pair, so the public key is only known
+	// after apply.
+	case !created && !plan.KeyGeneration.IsNull() && plan.RotateOnChange.ValueBool() && !isImportedSigningKey(ctx, req.Private) && plan.keyChanged(state):
+		plan.PublicKey = types.StringUnknown()
+		plan.setPublicKeyDetailsUnknown()
+	case !created && plan.PublicKey.Equal(state.PublicKey):
+		// UseStateForUnknown does not keep the expiry date of a key which does
+		// not expire.
+		plan.ExpiresAt = state.ExpiresAt
+	case plan.PublicKey.IsUnknown():
+		plan.setPublicKeyDetailsUnknown()
+	default:
+		resp.Diagnostics.Append(plan.setPublicKeyDetails()...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	addExpiryDiagnostics(&resp.Diagnostics, plan.Alias.ValueString(), plan.ExpiresAt, plan.ExpiryWarningDays)
+}
+
 func (r *SigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -219,29 +753,320 @@ func (r *SigningKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	signingKey := SigningKeyPutRequestAPIModel{
-		NewAlias: plan.Alias.ValueString(),
+	// The report is only replaced when the signing key is uploaded again.
+	plan.PropagationReport = state.PropagationReport
+
+	// The public key is unknown when a new key pair is generated.
+	if !plan.PublicKey.IsUnknown() {
+		resp.Diagnostics.Append(plan.setPublicKeyDetails()...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Key material of an imported signing key is adopted from the configuration
+	// without uploading it again.
+	// The state tracks a temporary alias if a rotation failed part way, which
+	// is finished by rotating again.
+	if plan.RotateOnChange.ValueBool() && !isImportedSigningKey(ctx, req.Private) &&
+		(plan.keyChanged(state) || isTemporaryAlias(plan.Alias.ValueString(), state.Alias.ValueString())) {
+		upload, diags := plan.keyMaterial(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		alias, result, diags := r.rotateSigningKey(ctx, upload, state)
+		resp.Diagnostics.Append(diags...)
+		// Nothing changed unless a signing key was moved to a new alias.
+		// Otherwise the state follows the new signing key, even on error, so
+		// the next apply finishes the rotation. Terraform does not mark the
+		// resource as tainted when an update fails.
+		if alias == "" {
+			return
+		}
+
+		// Only the rotation which failed part way was finished.
+		if result == nil && resp.Diagnostics.HasError() {
+			state.Alias = types.StringValue(alias)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		plan.Alias = types.StringValue(alias)
+
+		if result != nil {
+			report, diags := propagationReportFromAPIModel(result.Report)
+			resp.Diagnostics.Append(diags...)
+			plan.PropagationReport = report
+
+			addPropagationFailureWarnings(&resp.Diagnostics, result.Report)
+		}
+	} else if !plan.Alias.Equal(state.Alias) {
+		if err := r.renameSigningKey(state.Protocol.ValueString(), state.Alias.ValueString(), plan.Alias.ValueString()); err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	// The configuration has been adopted, so later changes to the key material
+	// replace the signing key again.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, signingKeyImportedKey, nil)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// rotateSigningKey uploads the planned signing key under a temporary alias,
+// which also makes it the default and propagates it, and only then moves the
+// current signing key out of the way, renames the new one to the planned alias
+// and deletes the current one. A signing key is stored under the alias in the
+// state at every step, so a failure leaves the state pointing at a key which
+// exists, and a rotation which failed part way is finished first. It returns
+// the alias the new signing key is stored under, which is empty if no signing
+// key was moved, and the upload response, which is nil if the signing key was
+// not uploaded. Aliases of keys which are left behind are reported in the
+// diagnostics.
+func (r *SigningKeyResource) rotateSigningKey(ctx context.Context, plan, state SigningKeyResourceModel) (alias string, result *SigningKeyPostResponseAPIModel, diags diag.Diagnostics) {
+	recoveredAlias, diags := r.finishRotation(ctx, plan, state)
+	if diags.HasError() {
+		return
+	}
+	if recoveredAlias != state.Alias.ValueString() {
+		alias = recoveredAlias
+		state.Alias = types.StringValue(recoveredAlias)
+	}
+
+	if !plan.keyChanged(state) {
+		return
 	}
 
+	var currentKey SigningKeyGetAPIModel
+
 	response, err := r.ProviderData.Client.R().
 		SetPathParams(map[string]string{
 			"protocol": state.Protocol.ValueString(),
 			"alias":    state.Alias.ValueString(),
 		}).
+		SetResult(&currentKey).
+		Get(SigningKeyEndpoint)
+	if err != nil {
+		diags.AddError("Unable to Rotate Signing Key", err.Error())
+		return
+	}
+
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		diags.AddError("Unable to Rotate Signing Key", fmt.Sprintf("Failed to read signing key %s: %s", state.Alias.ValueString(), response.String()))
+		return
+	}
+	currentKeyFound := response.StatusCode() != http.StatusNotFound
+
+	var signingKey SigningKeyPostRequestAPIModel
+	diags.Append(plan.toAPIModel(ctx, &signingKey)...)
+	if diags.HasError() {
+		return
+	}
+
+	suffix := time.Now().Unix()
+	temporaryAlias := fmt.Sprintf("%s-%d", plan.Alias.ValueString(), suffix)
+	retiredAlias := fmt.Sprintf("%s-%d-retired", state.Alias.ValueString(), suffix)
+
+	signingKey.Key.Alias = temporaryAlias
+	// Signing must keep working with the default key while the current key is
+	// being replaced.
+	signingKey.SetAsDefault = signingKey.SetAsDefault || currentKey.IsDefault
+
+	var uploaded SigningKeyPostResponseAPIModel
+	var postErr SigningKeyPostErrorAPIModel
+
+	response, err = r.ProviderData.Client.R().
+		SetPathParam("protocol", plan.Protocol.ValueString()).
 		SetBody(signingKey).
-		Put(SigningKeyEndpoint)
+		SetResult(&uploaded).
+		SetError(&postErr).
+		Post(SigningKeysEndpoint)
 	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		diags.AddError("Unable to Rotate Signing Key", err.Error())
 		return
 	}
 
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, response.String())
+		diags.AddError("Unable to Rotate Signing Key", fmt.Sprintf("Failed to upload signing key %s: %s", temporaryAlias, postErr.String()))
 		return
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Signing Key Rotate", map[string]interface{}{
+		"report": uploaded.Report.String(),
+	})
+	result = &uploaded
+
+	// From here on the new signing key is tracked under the temporary alias
+	// until it is renamed.
+	alias = temporaryAlias
+
+	if currentKeyFound {
+		if err := r.renameSigningKey(plan.Protocol.ValueString(), state.Alias.ValueString(), retiredAlias); err != nil {
+			diags.AddError(
+				"Unable to Rotate Signing Key",
+				fmt.Sprintf("The new signing key is uploaded as %s, but the current signing key %s is left behind. Apply again to finish the rotation: %s", temporaryAlias, state.Alias.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	if err := r.renameSigningKey(plan.Protocol.ValueString(), temporaryAlias, plan.Alias.ValueString()); err != nil {
+		detail := fmt.Sprintf("The new signing key is uploaded as %s. Apply again to finish the rotation: %s", temporaryAlias, err)
+		if currentKeyFound {
+			detail = fmt.Sprintf("The new signing key is uploaded as %s, and the previous signing key %s was renamed to %s. Apply again to finish the rotation: %s", temporaryAlias, state.Alias.ValueString(), retiredAlias, err)
+		}
+		diags.AddError("Unable to Rotate Signing Key", detail)
+		return
+	}
+	alias = plan.Alias.ValueString()
+
+	if !currentKeyFound {
+		return
+	}
+
+	if err := r.deleteSigningKey(plan.Protocol.ValueString(), retiredAlias); err != nil {
+		diags.AddWarning(
+			"Unable to Delete Previous Signing Key",
+			fmt.Sprintf("The signing key was rotated, but the previous signing key %s was renamed to %s, which is deleted by the next rotation or must be deleted manually: %s", state.Alias.ValueString(), retiredAlias, err),
+		)
+	}
+
+	return
+}
+
+// Rotations upload the new signing key under a temporary alias, and retire
+// the previous one, with the Unix time as suffix, e.g. my-key-1700000000 and
+// my-key-1700000000-retired.
+var (
+	temporaryAliasSuffix = regexp.MustCompile(`^-\d{10,}$`)
+	retiredAliasSuffix   = regexp.MustCompile(`^-\d{10,}-retired$`)
+)
+
+// isTemporaryAlias returns whether temporaryAlias is an alias a rotation to
+// alias uploads the new signing key under.
+func isTemporaryAlias(alias, temporaryAlias string) bool {
+	suffix, found := strings.CutPrefix(temporaryAlias, alias)
+	return found && temporaryAliasSuffix.MatchString(suffix)
+}
+
+// isRetiredAlias returns whether retiredAlias is an alias a rotation moves the
+// signing key stored under alias to.
+func isRetiredAlias(alias, retiredAlias string) bool {
+	suffix, found := strings.CutPrefix(retiredAlias, alias)
+	return found && retiredAliasSuffix.MatchString(suffix)
+}
+
+// finishRotation cleans up after a rotation which failed part way. If the
+// state tracks the new signing key under a temporary alias, the previous
+// signing key still stored under the planned alias is deleted and the new one
+// renamed to it. Temporary signing keys which are not tracked in the state
+// were never adopted, so their rotation is rolled back by deleting them.
+// Retired signing keys are deleted. It returns the alias the signing key in
+// the state is stored under afterwards.
+func (r *SigningKeyResource) finishRotation(ctx context.Context, plan, state SigningKeyResourceModel) (alias string, diags diag.Diagnostics) {
+	protocol := state.Protocol.ValueString()
+	alias = state.Alias.ValueString()
+
+	var signingKeys []SigningKeyGetAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("protocol", protocol).
+		SetResult(&signingKeys).
+		Get(SigningKeysEndpoint)
+	if err != nil {
+		diags.AddError("Unable to Rotate Signing Key", err.Error())
+		return
+	}
+
+	if response.IsError() {
+		diags.AddError("Unable to Rotate Signing Key", fmt.Sprintf("Failed to read signing keys: %s", response.String()))
+		return
+	}
+
+	// The previous signing key is still stored under the planned alias if it
+	// could not be retired.
+	finish := isTemporaryAlias(plan.Alias.ValueString(), alias)
+	leftover := func(leftoverAlias string) bool {
+		return leftoverAlias != alias &&
+			(isTemporaryAlias(plan.Alias.ValueString(), leftoverAlias) ||
+				isRetiredAlias(plan.Alias.ValueString(), leftoverAlias) ||
+				isRetiredAlias(state.Alias.ValueString(), leftoverAlias) ||
+				finish && leftoverAlias == plan.Alias.ValueString())
+	}
+
+	for _, signingKey := range signingKeys {
+		if !leftover(signingKey.Alias) {
+			continue
+		}
+
+		tflog.Info(ctx, "Signing Key Rotate: deleting leftover signing key", map[string]interface{}{
+			"alias": signingKey.Alias,
+		})
+
+		if err := r.deleteSigningKey(protocol, signingKey.Alias); err != nil {
+			diags.AddError(
+				"Unable to Rotate Signing Key",
+				fmt.Sprintf("Failed to delete signing key %s left behind by a previous rotation: %s", signingKey.Alias, err),
+			)
+			return
+		}
+	}
+
+	if !finish {
+		return
+	}
+
+	if err := r.renameSigningKey(protocol, alias, plan.Alias.ValueString()); err != nil {
+		diags.AddError("Unable to Rotate Signing Key", fmt.Sprintf("The new signing key is uploaded as %s. Apply again to finish the rotation: %s", alias, err))
+		return
+	}
+
+	return plan.Alias.ValueString(), diags
+}
+
+// deleteSigningKey deletes the signing key stored under alias. A signing key
+// which does not exist is ignored.
+func (r *SigningKeyResource) deleteSigningKey(protocol, alias string) error {
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": protocol,
+			"alias":    alias,
+		}).
+		Delete(SigningKeyEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("failed to delete signing key %s: %s", alias, response.String())
+	}
+
+	return nil
+}
+
+// renameSigningKey moves the signing key stored under alias to newAlias.
+func (r *SigningKeyResource) renameSigningKey(protocol, alias, newAlias string) error {
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": protocol,
+			"alias":    alias,
+		}).
+		SetBody(SigningKeyPutRequestAPIModel{
+			NewAlias: newAlias,
+		}).
+		Put(SigningKeyEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("failed to rename signing key %s to %s: %s", alias, newAlias, response.String())
+	}
+
+	return nil
 }
 
 func (r *SigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -272,3 +1097,8 @@ func (r *SigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// If the logic reaches here, it implicitly succeeded and will remove
 	// the resource from state if there are no other errors.
 }
+
+// ImportState imports the resource into the Terraform state.
+func (r *SigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSigningKeyState(ctx, req, resp)
+}
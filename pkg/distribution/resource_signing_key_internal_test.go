@@ -0,0 +1,204 @@
+package distribution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+// fakeSigningKeys serves the signing key API of Distribution for a single
+// protocol. Renaming a signing key fails while failRename returns true for
+// its alias.
+type fakeSigningKeys struct {
+	mu         sync.Mutex
+	keys       map[string]SigningKeyGetAPIModel
+	failRename func(alias string) bool
+}
+
+func (f *fakeSigningKeys) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	alias, found := strings.CutPrefix(r.URL.Path, "/distribution/api/v1/keys/gpg/")
+	if !found && r.URL.Path != "/distribution/api/v1/keys/gpg" {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case !found && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(lo.Values(f.keys))
+	case !found && r.Method == http.MethodPost:
+		var signingKey SigningKeyPostRequestAPIModel
+		_ = json.NewDecoder(r.Body).Decode(&signingKey)
+		f.keys[signingKey.Key.Alias] = SigningKeyGetAPIModel{
+			Alias:     signingKey.Key.Alias,
+			PublicKey: signingKey.Key.PublicKey,
+		}
+		_ = json.NewEncoder(w).Encode(SigningKeyPostResponseAPIModel{})
+	case r.Method == http.MethodGet:
+		signingKey, exists := f.keys[alias]
+		if !exists {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(signingKey)
+	case r.Method == http.MethodPut:
+		var rename SigningKeyPutRequestAPIModel
+		_ = json.NewDecoder(r.Body).Decode(&rename)
+		signingKey, exists := f.keys[alias]
+		_, conflict := f.keys[rename.NewAlias]
+		if !exists || conflict || f.failRename(alias) {
+			http.Error(w, "unable to rename signing key", http.StatusBadRequest)
+			return
+		}
+		delete(f.keys, alias)
+		signingKey.Alias = rename.NewAlias
+		f.keys[rename.NewAlias] = signingKey
+	case r.Method == http.MethodDelete:
+		delete(f.keys, alias)
+	}
+}
+
+// publicKeys returns the public keys by alias.
+func (f *fakeSigningKeys) publicKeys() map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return lo.MapValues(f.keys, func(signingKey SigningKeyGetAPIModel, _ string) string {
+		return signingKey.PublicKey
+	})
+}
+
+func newTestSigningKeyResource(t *testing.T, handler http.Handler) *SigningKeyResource {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient.SetRetryCount(0)
+
+	return &SigningKeyResource{
+		ProviderData: util.ProviderMetadata{Client: restyClient},
+	}
+}
+
+func testSigningKeyModel(alias, publicKey string) SigningKeyResourceModel {
+	var model SigningKeyResourceModel
+	model.Protocol = types.StringValue("gpg")
+	model.Alias = types.StringValue(alias)
+	model.PublicKey = types.StringValue(publicKey)
+	model.PrivateKey = types.StringValue("private-" + publicKey)
+
+	return model
+}
+
+func TestRotateSigningKey_partial_failure(t *testing.T) {
+	testCases := []struct {
+		name       string
+		failRename func(alias string) bool
+		leftover   []string
+	}{
+		{
+			name: "previous signing key not retired",
+			failRename: func(alias string) bool {
+				return alias == "my-key"
+			},
+			leftover: []string{"my-key", "my-key-*"},
+		},
+		{
+			name: "new signing key not renamed",
+			failRename: func(alias string) bool {
+				return isTemporaryAlias("my-key", alias)
+			},
+			leftover: []string{"my-key-*", "my-key-*-retired"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			signingKeys := &fakeSigningKeys{
+				keys: map[string]SigningKeyGetAPIModel{
+					"my-key": {Alias: "my-key", PublicKey: "old"},
+				},
+				failRename: testCase.failRename,
+			}
+			r := newTestSigningKeyResource(t, signingKeys)
+
+			state := testSigningKeyModel("my-key", "old")
+			plan := testSigningKeyModel("my-key", "new")
+
+			alias, result, diags := r.rotateSigningKey(context.Background(), plan, state)
+			if !diags.HasError() {
+				t.Fatal("expected the rotation to fail")
+			}
+			if !isTemporaryAlias("my-key", alias) || result == nil {
+				t.Fatalf("expected the new signing key to be tracked under a temporary alias, got %q", alias)
+			}
+
+			leftover := lo.Map(lo.Keys(signingKeys.publicKeys()), func(leftoverAlias string, _ int) string {
+				return strings.Replace(leftoverAlias, strings.TrimPrefix(alias, "my-key"), "-*", 1)
+			})
+			if !lo.ElementsMatch(leftover, testCase.leftover) {
+				t.Fatalf("expected signing keys %v to be left behind, got %v", testCase.leftover, leftover)
+			}
+
+			// The state follows the new signing key, so the next apply
+			// finishes the rotation.
+			signingKeys.failRename = func(string) bool { return false }
+			state = plan
+			state.Alias = types.StringValue(alias)
+
+			alias, result, diags = r.rotateSigningKey(context.Background(), plan, state)
+			if diags.HasError() {
+				t.Fatalf("expected the rotation to be finished, got %v", diags)
+			}
+			if alias != "my-key" || result != nil {
+				t.Fatalf("expected the new signing key to be renamed without uploading it again, got %q", alias)
+			}
+
+			expected := map[string]string{"my-key": "new"}
+			if publicKeys := signingKeys.publicKeys(); !lo.ElementsMatch(lo.Entries(publicKeys), lo.Entries(expected)) {
+				t.Fatalf("expected signing keys %v, got %v", expected, publicKeys)
+			}
+		})
+	}
+}
+
+func TestRotateSigningKey_untracked_temporary_signing_key(t *testing.T) {
+	signingKeys := &fakeSigningKeys{
+		keys: map[string]SigningKeyGetAPIModel{
+			"my-key":            {Alias: "my-key", PublicKey: "old"},
+			"my-key-1700000000": {Alias: "my-key-1700000000", PublicKey: "abandoned"},
+			"my-key-2024":       {Alias: "my-key-2024", PublicKey: "unrelated"},
+		},
+		failRename: func(string) bool { return false },
+	}
+	r := newTestSigningKeyResource(t, signingKeys)
+
+	alias, result, diags := r.rotateSigningKey(context.Background(), testSigningKeyModel("my-key", "new"), testSigningKeyModel("my-key", "old"))
+	if diags.HasError() {
+		t.Fatalf("expected the rotation to succeed, got %v", diags)
+	}
+	if alias != "my-key" || result == nil {
+		t.Fatalf("expected the new signing key to be uploaded as my-key, got %q", alias)
+	}
+
+	expected := map[string]string{"my-key": "new", "my-key-2024": "unrelated"}
+	if publicKeys := signingKeys.publicKeys(); !lo.ElementsMatch(lo.Entries(publicKeys), lo.Entries(expected)) {
+		t.Fatalf("expected signing keys %v, got %v", expected, publicKeys)
+	}
+}
@@ -0,0 +1,321 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const SigningKeyPropagateEndpoint = "distribution/api/v1/keys/{protocol}/{alias}/propagate"
+
+func NewSigningKeyPropagationResource() resource.Resource {
+	return &SigningKeyPropagationResource{
+		TypeName: "distribution_signing_key_propagation",
+	}
+}
+
+type SigningKeyPropagationResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SigningKeyPropagationResourceModel struct {
+	Protocol          types.String `tfsdk:"protocol"`
+	Alias             types.String `tfsdk:"alias"`
+	JPDIDs            types.Set    `tfsdk:"jpd_ids"`
+	PropagationReport types.List   `tfsdk:"propagation_report"`
+}
+
+func (m SigningKeyPropagationResourceModel) toAPIModel(ctx context.Context, apiModel *SigningKeyPropagateRequestAPIModel) (diags diag.Diagnostics) {
+	if !m.JPDIDs.IsNull() {
+		diags.Append(m.JPDIDs.ElementsAs(ctx, &apiModel.JPDIDs, false)...)
+	}
+
+	return
+}
+
+type SigningKeyPropagateRequestAPIModel struct {
+	JPDIDs []string `json:"jpd_ids,omitempty"`
+}
+
+func (r *SigningKeyPropagationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *SigningKeyPropagationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gpg", "pgp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Type of the signing key. Valid value: `gpg` or `pgp`",
+			},
+			"alias": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Alias of the signing key to propagate",
+			},
+			"jpd_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				MarkdownDescription: "IDs of the JFrog Platform Deployments (JPD) of the Edge Nodes to propagate the signing key to. If not provided, the signing key is propagated to all Edge Nodes, including the ones registered later. See the `id` attribute of the `distribution_edge_nodes` data source.",
+			},
+			"propagation_report": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: propagationReportSchemaAttributes,
+				},
+				Computed:    true,
+				Description: "Result of the last propagation for each Edge Node.",
+			},
+		},
+		MarkdownDescription: "This resource enables you to propagate a signing key to Edge Nodes. The signing key is propagated again when an Edge Node reported a failure, when the chosen Edge Nodes change, or when a new Edge Node is registered if no Edge Nodes are chosen. New Edge Nodes are only detected if the JPDs registered in Mission Control can be listed. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing) and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).\n\n" +
+			"~>The signing key cannot be removed from the Edge Nodes. Destroying this resource only removes it from the Terraform state.",
+	}
+}
+
+func (r *SigningKeyPropagationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// propagate propagates the signing key and records the report in the model.
+// Edge Nodes which failed are reported as warnings, as the next plan
+// propagates the signing key to them again.
+func (r *SigningKeyPropagationResource) propagate(ctx context.Context, plan *SigningKeyPropagationResourceModel) (diags diag.Diagnostics, err error) {
+	var propagateRequest SigningKeyPropagateRequestAPIModel
+	diags.Append(plan.toAPIModel(ctx, &propagateRequest)...)
+	if diags.HasError() {
+		return
+	}
+
+	var result SigningKeyPostResponseAPIModel
+	var postErr SigningKeyPostErrorAPIModel
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": plan.Protocol.ValueString(),
+			"alias":    plan.Alias.ValueString(),
+		}).
+		SetBody(propagateRequest).
+		SetResult(&result).
+		SetError(&postErr).
+		Post(SigningKeyPropagateEndpoint)
+	if err != nil {
+		return
+	}
+
+	if response.IsError() {
+		err = fmt.Errorf("%s", postErr.String())
+		return
+	}
+
+	tflog.Info(ctx, "Signing Key Propagation", map[string]interface{}{
+		"report": result.Report.String(),
+	})
+
+	report, ds := propagationReportFromAPIModel(result.Report)
+	diags.Append(ds...)
+	plan.PropagationReport = report
+
+	addPropagationFailureWarnings(&diags, result.Report)
+
+	return
+}
+
+func (r *SigningKeyPropagationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan SigningKeyPropagationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, err := r.propagate(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SigningKeyPropagationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state SigningKeyPropagationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": state.Protocol.ValueString(),
+			"alias":    state.Alias.ValueString(),
+		}).
+		Get(SigningKeyEndpoint)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// The signing key no longer exists, so a new one under the same alias needs
+	// to be propagated again.
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// Distribution does not report which Edge Nodes hold the signing key, so
+	// the report of the last propagation is kept.
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan plans a new propagation when an Edge Node failed to receive the
+// signing key, or when a new Edge Node was registered since the last
+// propagation to all Edge Nodes.
+func (r *SigningKeyPropagationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SigningKeyPropagationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state SigningKeyPropagationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changed attributes already lead to a new propagation.
+	if plan.PropagationReport.IsUnknown() {
+		return
+	}
+
+	var report []SigningKeyReportDetailPostResponseAPIModel
+	resp.Diagnostics.Append(state.PropagationReport.ElementsAs(ctx, &report, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repropagate := lo.SomeBy(
+		report,
+		func(detail SigningKeyReportDetailPostResponseAPIModel) bool {
+			return detail.Status != signingKeyReportStatusSuccess
+		},
+	)
+
+	if !repropagate && plan.JPDIDs.IsNull() {
+		// The Edge Node inventory is optional, so new Edge Nodes are not
+		// detected if it cannot be read.
+		edges, err := listEdgeNodes(r.ProviderData)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to read Edge Nodes, new Edge Nodes are not detected", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+
+		propagatedJPDIDs := lo.Map(
+			report,
+			func(detail SigningKeyReportDetailPostResponseAPIModel, _ int) string {
+				return detail.JPDID
+			},
+		)
+
+		repropagate = lo.SomeBy(
+			edges,
+			func(edge JPDAPIModel) bool {
+				return !lo.Contains(propagatedJPDIDs, edge.ID)
+			},
+		)
+	}
+
+	if repropagate {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("propagation_report"), types.ListUnknown(propagationReportObjectType))...)
+	}
+}
+
+func (r *SigningKeyPropagationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan SigningKeyPropagationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, err := r.propagate(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SigningKeyPropagationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	// Distribution has no API to remove a signing key from Edge Nodes, so the
+	// resource is only removed from the Terraform state.
+	resp.Diagnostics.AddWarning(
+		"Signing Key Not Removed From Edge Nodes",
+		"Distribution does not support removing a propagated signing key. The signing key remains on the Edge Nodes.",
+	)
+}
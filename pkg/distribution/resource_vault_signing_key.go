@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,15 +33,18 @@ type VaultSigningKeyResource struct {
 
 type VaultSigningKeyResourceModel struct {
 	SigningKeyCommmonResourceModel
-	VaultID    types.String `tfsdk:"vault_id"`
-	PublicKey  types.Object `tfsdk:"public_key"`
-	PrivateKey types.Object `tfsdk:"private_key"`
+	VaultID     types.String `tfsdk:"vault_id"`
+	PublicKey   types.Object `tfsdk:"public_key"`
+	PrivateKey  types.Object `tfsdk:"private_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
 }
 
 func (m VaultSigningKeyResourceModel) toAPIModel(_ context.Context, apiModel *VaultSigningKeyPostRequestAPIModel) (diags diag.Diagnostics) {
 	publicKeyAttrs := m.PublicKey.Attributes()
 	privateAttrs := m.PrivateKey.Attributes()
 	apiModel.Key = VaultSigningKeyKeyPostRequestAPIModel{
+		Alias: m.Alias.ValueString(),
 		VaultData: VaultSigningKeyKeyVaultDataPostRequestAPIModel{
 			ID: m.VaultID.ValueString(),
 			PublicKey: VaultSigningKeyVaultDataPathKeyRequestAPIModel{
@@ -66,6 +70,7 @@ type VaultSigningKeyPostRequestAPIModel struct {
 }
 
 type VaultSigningKeyKeyPostRequestAPIModel struct {
+	Alias     string                                         `json:"alias,omitempty"`
 	VaultData VaultSigningKeyKeyVaultDataPostRequestAPIModel `json:"vault_data"`
 }
 
@@ -90,7 +95,29 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 			commonSchemaAttributes,
 			map[string]schema.Attribute{
 				"alias": schema.StringAttribute{
+					Optional: true,
 					Computed: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Alias of the signing key. If not set, the alias assigned by Distribution is used.",
+				},
+				"fingerprint": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Fingerprint of the public key uploaded to Distribution. A change of the key outside of Terraform is detected with it and replaces the resource.",
+				},
+				"expires_at": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "Expiry date of the public key uploaded to Distribution in RFC 3339 format. Not set if the key does not expire.",
 				},
 				"vault_id": schema.StringAttribute{
 					Required: true,
@@ -98,7 +125,7 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 						stringvalidator.LengthAtLeast(1),
 					},
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						stringRequiresReplaceUnlessImported(),
 					},
 					Description: "Name of the Vault integration in Artifactory",
 				},
@@ -110,7 +137,7 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 								stringvalidator.LengthAtLeast(1),
 							},
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								stringRequiresReplaceUnlessImported(),
 							},
 							MarkdownDescription: "Path to the key, e.g. `secret/my-key`",
 						},
@@ -120,7 +147,7 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 								stringvalidator.LengthAtLeast(1),
 							},
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								stringRequiresReplaceUnlessImported(),
 							},
 							MarkdownDescription: "Field name of the key, e.g. `public`",
 						},
@@ -135,7 +162,7 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 								stringvalidator.LengthAtLeast(1),
 							},
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								stringRequiresReplaceUnlessImported(),
 							},
 							MarkdownDescription: "Path to the key, e.g. `secret/my-key`",
 						},
@@ -145,7 +172,7 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 								stringvalidator.LengthAtLeast(1),
 							},
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								stringRequiresReplaceUnlessImported(),
 							},
 							MarkdownDescription: "Field name of the key, e.g. `private`",
 						},
@@ -154,7 +181,8 @@ func (r *VaultSigningKeyResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		),
-		MarkdownDescription: "This resource enables you to distribute GPG keys (store in HashiCorp Vault) to sign Release Bundle V1. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing), [Vault integration](https://jfrog.com/help/r/jfrog-platform-administration-documentation/vault), and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).",
+		MarkdownDescription: "This resource enables you to distribute GPG keys (store in HashiCorp Vault) to sign Release Bundle V1. For more information, see [GPG Signing](https://jfrog.com/help/r/jfrog-distribution-documentation/gpg-signing), [Vault integration](https://jfrog.com/help/r/jfrog-platform-administration-documentation/vault), and [REST API](https://jfrog.com/help/r/jfrog-rest-apis/signing-keys).\n\n" +
+			"~>The Vault integration and key locations cannot be read from Distribution, so only a signing key replaced outside of Terraform is detected, through its `fingerprint`. When importing an existing signing key, set them in the configuration to match the uploaded key. They are adopted on the next apply without re-uploading the key.",
 	}
 }
 
@@ -206,19 +234,83 @@ func (r *VaultSigningKeyResource) Create(ctx context.Context, req resource.Creat
 		"report": result.Report.String(),
 	})
 
-	successJPD, found := lo.Find(
-		result.Report.Details,
-		func(detail SigningKeyReportDetailPostResponseAPIModel) bool {
-			return detail.Status == "SUCCESS"
-		},
-	)
+	report, diags := propagationReportFromAPIModel(result.Report)
+	resp.Diagnostics.Append(diags...)
+	plan.PropagationReport = report
+
+	// Without a configured alias, the alias assigned by Distribution is taken
+	// from the report. Failed edges report it too, so a key stored on the
+	// source JPD is found even if no edge succeeded.
+	if plan.Alias.IsUnknown() {
+		detail, found := lo.Find(
+			result.Report.Details,
+			func(detail SigningKeyReportDetailPostResponseAPIModel) bool {
+				return detail.Status == signingKeyReportStatusSuccess
+			},
+		)
+		if !found {
+			detail, found = lo.Find(
+				result.Report.Details,
+				func(detail SigningKeyReportDetailPostResponseAPIModel) bool {
+					return detail.KeyAlias != ""
+				},
+			)
+		}
+
+		if !found {
+			utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("Failed to deploy signing key, no alias was reported. Set `alias` to deploy it under a known alias. Report: %s", result.Report.failureSummary()))
+			return
+		}
+
+		plan.Alias = types.StringValue(detail.KeyAlias)
+	}
 
-	if !found {
-		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("Failed to deploy signing key: %s", result.Report.Status))
+	// The signing key may exist under the alias at this point so it is saved
+	// into state before it is read back, which Terraform will mark as tainted
+	// if reading it fails.
+	plan.Fingerprint = types.StringNull()
+	plan.ExpiresAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	var uploadedKey SigningKeyGetAPIModel
+
+	response, err = r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"protocol": plan.Protocol.ValueString(),
+			"alias":    plan.Alias.ValueString(),
+		}).
+		SetResult(&uploadedKey).
+		Get(SigningKeyEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
 		return
 	}
 
-	plan.Alias = types.StringValue(successJPD.KeyAlias)
+	// The signing key is only stored if the source JPD succeeded, whatever
+	// the result on the edges.
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("Failed to deploy signing key %s. Report: %s", plan.Alias.ValueString(), result.Report.failureSummary()))
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	addPropagationFailureWarnings(&resp.Diagnostics, result.Report)
+
+	details, err := readPublicKeyDetails(uploadedKey.PublicKey)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Parse Public Key",
+			fmt.Sprintf("Failed to parse the public key of signing key %s, fingerprint and expires_at are not set: %s", plan.Alias.ValueString(), err),
+		)
+	} else {
+		plan.Fingerprint = types.StringValue(details.Fingerprint)
+		plan.ExpiresAt = expiresAtValue(details)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -259,10 +351,63 @@ func (r *VaultSigningKeyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// A public key which cannot be parsed must not break refresh. The previous
+	// details are kept instead, and drift cannot be detected.
+	details, err := readPublicKeyDetails(signingKey.PublicKey)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Parse Public Key",
+			fmt.Sprintf("Failed to parse the public key returned by Distribution for signing key %s, the previous fingerprint and expires_at are kept: %s", state.Alias.ValueString(), err),
+		)
+	} else {
+		// The key was replaced outside of Terraform under the same alias. The key
+		// locations in Vault are unchanged, so ModifyPlan forces the replacement.
+		if !state.Fingerprint.IsNull() && state.Fingerprint.ValueString() != details.Fingerprint {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, signingKeyReplacedKey, []byte("true"))...)
+		}
+		state.Fingerprint = types.StringValue(details.Fingerprint)
+		state.ExpiresAt = expiresAtValue(details)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *VaultSigningKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VaultSigningKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state VaultSigningKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	replaced, diags := req.Private.GetKey(ctx, signingKeyReplacedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if string(replaced) == "true" {
+		plan.Fingerprint = types.StringUnknown()
+		plan.ExpiresAt = types.StringUnknown()
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("fingerprint"))
+	} else {
+		// UseStateForUnknown does not keep the expiry date of a key which does
+		// not expire.
+		plan.ExpiresAt = state.ExpiresAt
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	// The key is only known once it is uploaded to Distribution, so only keys
+	// already managed by Terraform are checked.
+	addExpiryDiagnostics(&resp.Diagnostics, state.Alias.ValueString(), plan.ExpiresAt, plan.ExpiryWarningDays)
+}
+
 func (r *VaultSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -282,26 +427,35 @@ func (r *VaultSigningKeyResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	signingKey := SigningKeyPutRequestAPIModel{
-		NewAlias: plan.Alias.ValueString(),
-	}
-
-	response, err := r.ProviderData.Client.R().
-		SetPathParams(map[string]string{
-			"protocol": state.Protocol.ValueString(),
-			"alias":    state.Alias.ValueString(),
-		}).
-		SetBody(signingKey).
-		Put(SigningKeyEndpoint)
-	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
-		return
+	// The signing key is never uploaded again, so the report is kept.
+	plan.PropagationReport = state.PropagationReport
+
+	if !plan.Alias.Equal(state.Alias) {
+		signingKey := SigningKeyPutRequestAPIModel{
+			NewAlias: plan.Alias.ValueString(),
+		}
+
+		response, err := r.ProviderData.Client.R().
+			SetPathParams(map[string]string{
+				"protocol": state.Protocol.ValueString(),
+				"alias":    state.Alias.ValueString(),
+			}).
+			SetBody(signingKey).
+			Put(SigningKeyEndpoint)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		if response.IsError() {
+			utilfw.UnableToUpdateResourceError(resp, response.String())
+			return
+		}
 	}
 
-	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, response.String())
-		return
-	}
+	// The configuration has been adopted, so later changes to the key material
+	// replace the signing key again.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, signingKeyImportedKey, nil)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -335,3 +489,8 @@ func (r *VaultSigningKeyResource) Delete(ctx context.Context, req resource.Delet
 	// If the logic reaches here, it implicitly succeeded and will remove
 	// the resource from state if there are no other errors.
 }
+
+// ImportState imports the resource into the Terraform state.
+func (r *VaultSigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSigningKeyState(ctx, req, resp)
+}
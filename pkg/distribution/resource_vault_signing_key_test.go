@@ -1,10 +1,13 @@
 package distribution_test
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -44,6 +47,7 @@ func TestAccVaultSigningKey_full(t *testing.T) {
 		propagate_to_edge_nodes = true
 		fail_on_propagation_failure = true
 		set_as_default = true
+		expiry_warning_days = 30
 	}`
 
 	testData := map[string]string{
@@ -69,8 +73,108 @@ func TestAccVaultSigningKey_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "propagate_to_edge_nodes", "true"),
 					resource.TestCheckResourceAttr(fqrn, "fail_on_propagation_failure", "true"),
 					resource.TestCheckResourceAttr(fqrn, "set_as_default", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "fingerprint"),
+					resource.TestCheckResourceAttrSet(fqrn, "propagation_report.#"),
+					resource.TestCheckResourceAttr(fqrn, "expiry_warning_days", "30"),
+				),
+			},
+			{
+				ResourceName:       fqrn,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccVaultSigningKeyImportStateIdFunc(fqrn),
+				ImportStatePersist: true,
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "public_key.path", testData["vault_secret_path"]),
+					resource.TestCheckResourceAttr(fqrn, "private_key.path", testData["vault_secret_path"]),
 				),
 			},
 		},
 	})
 }
+
+func TestAccVaultSigningKey_alias(t *testing.T) {
+	vaultID := os.Getenv("JFROG_VAULT_ID")
+	if vaultID == "" {
+		t.Skipf("env var JFROG_VAULT_ID is not set.")
+	}
+
+	_, fqrn, resourceName := testutil.MkNames("test-vault-signing-key", "distribution_vault_signing_key")
+
+	const template = `
+	resource "distribution_vault_signing_key" "{{ .name }}" {
+		protocol = "gpg"
+		alias    = "{{ .alias }}"
+		vault_id = "{{ .vault_id }}"
+
+		public_key = {
+			path = "{{ .vault_secret_path }}"
+			key = "public"
+		}
+
+		private_key = {
+			path = "{{ .vault_secret_path }}"
+			key = "private"
+		}
+
+		propagate_to_edge_nodes = true
+		fail_on_propagation_failure = false
+		set_as_default = false
+	}`
+
+	testData := map[string]string{
+		"name":              resourceName,
+		"alias":             resourceName,
+		"vault_id":          vaultID,
+		"vault_secret_path": "secret/signing_key",
+	}
+
+	config := util.ExecuteTemplate("TestAccVaultSigningKey_alias", template, testData)
+
+	testData["alias"] = fmt.Sprintf("%s-renamed", resourceName)
+	renamedConfig := util.ExecuteTemplate("TestAccVaultSigningKey_alias", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "alias", resourceName),
+					resource.TestCheckResourceAttrSet(fqrn, "fingerprint"),
+				),
+			},
+			{
+				Config: renamedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "alias", testData["alias"]),
+				),
+			},
+		},
+	})
+}
+
+// Without a configured alias, the alias of a Vault signing key is assigned by
+// Distribution, so the import ID is built from the state.
+func testAccVaultSigningKeyImportStateIdFunc(fqrn string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[fqrn]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", fqrn)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["protocol"], rs.Primary.Attributes["alias"]), nil
+	}
+}
@@ -1,10 +1,17 @@
 package distribution
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,14 +24,144 @@ import (
 const (
 	SigningKeysEndpoint = "distribution/api/v1/keys/{protocol}"
 	SigningKeyEndpoint  = "distribution/api/v1/keys/{protocol}/{alias}"
+
+	// signingKeyImportedKey is the private state key marking a signing key
+	// imported into Terraform, until its first update.
+	signingKeyImportedKey = "imported"
+
+	// signingKeyReplacedKey is the private state key marking a signing key
+	// replaced outside of Terraform under the same alias.
+	signingKeyReplacedKey = "replaced"
+
+	signingKeyReportStatusSuccess = "SUCCESS"
 )
 
+var propagationReportAttrType = map[string]attr.Type{
+	"jpd_id":    types.StringType,
+	"name":      types.StringType,
+	"key_alias": types.StringType,
+	"status":    types.StringType,
+}
+
+var propagationReportObjectType = types.ObjectType{
+	AttrTypes: propagationReportAttrType,
+}
+
+var propagationReportSchemaAttributes = map[string]schema.Attribute{
+	"jpd_id": schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the JFrog Platform Deployment (JPD) of the Edge Node.",
+	},
+	"name": schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the Edge Node.",
+	},
+	"key_alias": schema.StringAttribute{
+		Computed:    true,
+		Description: "Alias of the signing key on the Edge Node.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Propagation status, e.g. `SUCCESS`.",
+	},
+}
+
+func propagationReportFromAPIModel(report SigningKeyReportPostResponseAPIModel) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	details := lo.Map(
+		report.Details,
+		func(detail SigningKeyReportDetailPostResponseAPIModel, _ int) attr.Value {
+			d, ds := types.ObjectValue(
+				propagationReportAttrType,
+				map[string]attr.Value{
+					"jpd_id":    types.StringValue(detail.JPDID),
+					"name":      types.StringValue(detail.Name),
+					"key_alias": types.StringValue(detail.KeyAlias),
+					"status":    types.StringValue(detail.Status),
+				},
+			)
+			if ds.HasError() {
+				diags.Append(ds...)
+			}
+
+			return d
+		},
+	)
+
+	list, ds := types.ListValue(propagationReportObjectType, details)
+	if ds.HasError() {
+		diags.Append(ds...)
+	}
+
+	return list, diags
+}
+
+// addPropagationFailureWarnings reports each JPD the key could not be
+// propagated to as a warning.
+func addPropagationFailureWarnings(diags *diag.Diagnostics, report SigningKeyReportPostResponseAPIModel) {
+	for _, detail := range report.failedDetails() {
+		diags.AddWarning(
+			"Signing Key Propagation Failed",
+			fmt.Sprintf("Failed to propagate signing key %s to %s (JPD ID: %s). Status: %s", detail.KeyAlias, detail.Name, detail.JPDID, detail.Status),
+		)
+	}
+}
+
+// expiresAtValue returns the expiry date of the public key in RFC 3339 format,
+// or null if the key does not expire.
+func expiresAtValue(details publicKeyDetails) types.String {
+	if details.ExpiresAt == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(details.ExpiresAt.Format(time.RFC3339))
+}
+
+// addExpiryDiagnostics reports an expired signing key as an error, and a
+// signing key expiring within expiryWarningDays as a warning. Nothing is
+// reported if the expiry date is not known yet.
+func addExpiryDiagnostics(diags *diag.Diagnostics, alias string, expiresAt types.String, expiryWarningDays types.Int64) {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	if !now.Before(expiry) {
+		diags.AddAttributeError(
+			path.Root("expires_at"),
+			"Signing Key Expired",
+			fmt.Sprintf("Signing key %s expired on %s. Release bundles cannot be signed with it until it is replaced.", alias, expiresAt.ValueString()),
+		)
+		return
+	}
+
+	if expiryWarningDays.IsNull() || expiryWarningDays.IsUnknown() {
+		return
+	}
+
+	if now.AddDate(0, 0, int(expiryWarningDays.ValueInt64())).After(expiry) {
+		diags.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Signing Key Expiring",
+			fmt.Sprintf("Signing key %s expires on %s, in less than %d days. Replace it before it expires.", alias, expiresAt.ValueString(), expiryWarningDays.ValueInt64()),
+		)
+	}
+}
+
 type SigningKeyCommmonResourceModel struct {
 	Protocol                 types.String `tfsdk:"protocol"`
 	Alias                    types.String `tfsdk:"alias"`
 	PropagateToEdgeNode      types.Bool   `tfsdk:"propagate_to_edge_nodes"`
 	FailOnPropagationFailure types.Bool   `tfsdk:"fail_on_propagation_failure"`
 	SetAsDefault             types.Bool   `tfsdk:"set_as_default"`
+	PropagationReport        types.List   `tfsdk:"propagation_report"`
+	ExpiryWarningDays        types.Int64  `tfsdk:"expiry_warning_days"`
 }
 
 type SigningKeyCommonPostRequestAPIModel struct {
@@ -44,10 +181,37 @@ type SigningKeyReportPostResponseAPIModel struct {
 }
 
 type SigningKeyReportDetailPostResponseAPIModel struct {
-	JPDID    string `json:"jpd_id"`
-	Name     string `json:"name"`
-	KeyAlias string `json:"key_alias"`
-	Status   string `json:"status"`
+	JPDID    string `tfsdk:"jpd_id" json:"jpd_id"`
+	Name     string `tfsdk:"name" json:"name"`
+	KeyAlias string `tfsdk:"key_alias" json:"key_alias"`
+	Status   string `tfsdk:"status" json:"status"`
+}
+
+// failedDetails returns the report details of the JPDs the key could not be
+// propagated to.
+func (m SigningKeyReportPostResponseAPIModel) failedDetails() []SigningKeyReportDetailPostResponseAPIModel {
+	return lo.Filter(
+		m.Details,
+		func(detail SigningKeyReportDetailPostResponseAPIModel, _ int) bool {
+			return detail.Status != signingKeyReportStatusSuccess
+		},
+	)
+}
+
+// failureSummary lists the JPDs the key could not be propagated to, one per
+// line.
+func (m SigningKeyReportPostResponseAPIModel) failureSummary() string {
+	failures := lo.Map(
+		m.failedDetails(),
+		func(detail SigningKeyReportDetailPostResponseAPIModel, _ int) string {
+			return fmt.Sprintf("- %s (JPD ID: %s): %s", detail.Name, detail.JPDID, detail.Status)
+		},
+	)
+	if len(failures) == 0 {
+		return fmt.Sprintf("%s: %s", m.Status, m.Message)
+	}
+
+	return fmt.Sprintf("%s: %s\n%s", m.Status, m.Message, strings.Join(failures, "\n"))
 }
 
 func (m SigningKeyReportPostResponseAPIModel) String() string {
@@ -73,12 +237,66 @@ func (m SigningKeyPostErrorAPIModel) String() string {
 type SigningKeyGetAPIModel struct {
 	Alias     string `json:"alias"`
 	PublicKey string `json:"public_key"`
+	IsDefault bool   `json:"is_default"`
 }
 
 type SigningKeyPutRequestAPIModel struct {
 	NewAlias string `json:"new_alias"`
 }
 
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// isImportedSigningKey returns whether the signing key was imported and not
+// updated since. The key material and upload options of an imported signing
+// key cannot be read from Distribution, so they are missing from its state.
+func isImportedSigningKey(ctx context.Context, private privateStateGetter) bool {
+	imported, _ := private.GetKey(ctx, signingKeyImportedKey)
+	return string(imported) == "true"
+}
+
+const requiresReplaceUnlessImportedDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the resource was imported and has not been updated since."
+
+// stringRequiresReplaceUnlessImported lets an imported signing key adopt the
+// configured value instead of being recreated, which would change the key.
+func stringRequiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !isImportedSigningKey(ctx, req.Private)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func boolRequiresReplaceUnlessImported() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !isImportedSigningKey(ctx, req.Private)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+// importSigningKeyState imports a signing key by its protocol:alias ID.
+func importSigningKeyState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected protocol:alias",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("protocol"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias"), parts[1])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, signingKeyImportedKey, []byte("true"))...)
+}
+
 var commonSchemaAttributes = map[string]schema.Attribute{
 	"alias": schema.StringAttribute{
 		Required: true,
@@ -100,22 +318,36 @@ var commonSchemaAttributes = map[string]schema.Attribute{
 	"propagate_to_edge_nodes": schema.BoolAttribute{
 		Optional: true,
 		PlanModifiers: []planmodifier.Bool{
-			boolplanmodifier.RequiresReplace(),
+			boolRequiresReplaceUnlessImported(),
 		},
 		MarkdownDescription: "When set to `true`, the public key will be automatically propagated to the Edge Node just once.",
 	},
 	"fail_on_propagation_failure": schema.BoolAttribute{
 		Optional: true,
 		PlanModifiers: []planmodifier.Bool{
-			boolplanmodifier.RequiresReplace(),
+			boolRequiresReplaceUnlessImported(),
 		},
 		MarkdownDescription: "When set to `true`, the public key will be automatically propagated to the Edge Node just once.",
 	},
 	"set_as_default": schema.BoolAttribute{
 		Optional: true,
 		PlanModifiers: []planmodifier.Bool{
-			boolplanmodifier.RequiresReplace(),
+			boolRequiresReplaceUnlessImported(),
 		},
 		MarkdownDescription: "Set this to `true` if this is the first key that is set or if there is no default key in Artifactory.",
 	},
+	"expiry_warning_days": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: "Number of days before the signing key expires to warn about it during plan. If not set, no warning is reported. A plan always fails once the signing key has expired.",
+	},
+	"propagation_report": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: propagationReportSchemaAttributes,
+		},
+		Computed:    true,
+		Description: "Result of the upload for each JFrog Platform Deployment (JPD), including the Edge Nodes the key was propagated to.",
+	},
 }
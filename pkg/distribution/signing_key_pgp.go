@@ -0,0 +1,195 @@
+package distribution
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+const (
+	keyAlgorithmRSA4096 = "rsa4096"
+	keyAlgorithmEd25519 = "ed25519"
+)
+
+// readArmoredKey parses an ASCII armored OpenPGP key and returns its first
+// entity.
+func readArmoredKey(armoredKey string) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("no key found")
+	}
+
+	return entities[0], nil
+}
+
+// isPrivateKeyChecksumFailure returns whether decrypting a private key failed
+// its checksum. Distribution accepts such keys, e.g. keys exported by Keybase.
+// Unless the private key is AEAD protected, a wrong passphrase fails with the
+// same error.
+func isPrivateKeyChecksumFailure(err error) bool {
+	return errors.Is(err, pgperrors.StructuralError("private key checksum failure"))
+}
+
+// publicKeyFingerprint returns the fingerprint of the primary key of an ASCII
+// armored OpenPGP public key, as an upper case hex string.
+func publicKeyFingerprint(armoredPublicKey string) (string, error) {
+	entity, err := readArmoredKey(armoredPublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint), nil
+}
+
+// publicKeyDetails describes the primary key of an OpenPGP public key.
+type publicKeyDetails struct {
+	Fingerprint string
+	KeyID       string
+	Algorithm   string
+	// ExpiresAt is nil if the key does not expire.
+	ExpiresAt *time.Time
+}
+
+// readPublicKeyDetails returns the details of the primary key of an ASCII
+// armored OpenPGP public key.
+func readPublicKeyDetails(armoredPublicKey string) (publicKeyDetails, error) {
+	entity, err := readArmoredKey(armoredPublicKey)
+	if err != nil {
+		return publicKeyDetails{}, err
+	}
+
+	details := publicKeyDetails{
+		Fingerprint: fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint),
+		KeyID:       entity.PrimaryKey.KeyIdString(),
+		Algorithm:   publicKeyAlgorithm(entity.PrimaryKey),
+	}
+
+	selfSignature, _ := entity.PrimarySelfSignature()
+	if selfSignature != nil && selfSignature.KeyLifetimeSecs != nil && *selfSignature.KeyLifetimeSecs != 0 {
+		expiresAt := entity.PrimaryKey.CreationTime.Add(time.Duration(*selfSignature.KeyLifetimeSecs) * time.Second).UTC()
+		details.ExpiresAt = &expiresAt
+	}
+
+	return details, nil
+}
+
+// publicKeyAlgorithm returns the algorithm of the public key in the format of
+// key_generation, e.g. rsa4096 or ed25519.
+func publicKeyAlgorithm(publicKey *packet.PublicKey) string {
+	switch publicKey.PubKeyAlgo {
+	case packet.PubKeyAlgoRSA, packet.PubKeyAlgoRSASignOnly, packet.PubKeyAlgoRSAEncryptOnly:
+		bitLength, _ := publicKey.BitLength()
+		return fmt.Sprintf("rsa%d", bitLength)
+	case packet.PubKeyAlgoDSA:
+		bitLength, _ := publicKey.BitLength()
+		return fmt.Sprintf("dsa%d", bitLength)
+	case packet.PubKeyAlgoEdDSA, packet.PubKeyAlgoEd25519, packet.PubKeyAlgoEd448:
+		curve, _ := publicKey.Curve()
+		switch curve {
+		case packet.Curve25519:
+			return keyAlgorithmEd25519
+		case packet.Curve448:
+			return "ed448"
+		}
+		return fmt.Sprintf("eddsa-%s", strings.ToLower(string(curve)))
+	case packet.PubKeyAlgoECDSA:
+		curve, _ := publicKey.Curve()
+		return fmt.Sprintf("ecdsa-%s", strings.ToLower(string(curve)))
+	}
+
+	return fmt.Sprintf("unknown-%d", publicKey.PubKeyAlgo)
+}
+
+// samePublicKey returns whether both ASCII armored public keys hold the same
+// primary key. Keys which cannot be parsed are compared as text, ignoring
+// surrounding whitespace.
+func samePublicKey(armoredPublicKey, otherArmoredPublicKey string) bool {
+	fingerprint, err := publicKeyFingerprint(armoredPublicKey)
+	if err != nil {
+		return strings.TrimSpace(armoredPublicKey) == strings.TrimSpace(otherArmoredPublicKey)
+	}
+
+	otherFingerprint, err := publicKeyFingerprint(otherArmoredPublicKey)
+	if err != nil {
+		return false
+	}
+
+	return fingerprint == otherFingerprint
+}
+
+// generateArmoredKeyPair generates an OpenPGP key pair with a signing primary
+// key and an encryption subkey. The private key is encrypted with the
+// passphrase. A lifetime of 0 generates a key which does not expire.
+func generateArmoredKeyPair(algorithm, name, comment, email string, lifetime time.Duration, passphrase string) (string, string, error) {
+	config := &packet.Config{
+		DefaultHash:     crypto.SHA256,
+		KeyLifetimeSecs: uint32(lifetime.Seconds()),
+	}
+
+	switch algorithm {
+	case keyAlgorithmRSA4096:
+		config.Algorithm = packet.PubKeyAlgoRSA
+		config.RSABits = 4096
+	case keyAlgorithmEd25519:
+		config.Algorithm = packet.PubKeyAlgoEdDSA
+		config.Curve = packet.Curve25519
+	default:
+		return "", "", fmt.Errorf("unsupported key algorithm %s", algorithm)
+	}
+
+	entity, err := openpgp.NewEntity(name, comment, email, config)
+	if err != nil {
+		return "", "", err
+	}
+
+	var publicKey bytes.Buffer
+	if err := armorEncode(&publicKey, openpgp.PublicKeyType, entity.Serialize); err != nil {
+		return "", "", err
+	}
+
+	if err := entity.EncryptPrivateKeys([]byte(passphrase), config); err != nil {
+		return "", "", err
+	}
+
+	// The identities and subkeys are signed by NewEntity already. Signing them
+	// again would require the decrypted private key.
+	var privateKey bytes.Buffer
+	err = armorEncode(
+		&privateKey,
+		openpgp.PrivateKeyType,
+		func(w io.Writer) error {
+			return entity.SerializePrivateWithoutSigning(w, config)
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+
+	return publicKey.String(), privateKey.String(), nil
+}
+
+func armorEncode(out io.Writer, blockType string, serialize func(w io.Writer) error) error {
+	w, err := armor.Encode(out, blockType, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := serialize(w); err != nil {
+		return err
+	}
+
+	return w.Close()
+}